@@ -2,30 +2,42 @@ package hub
 
 import (
 	"encoding/json"
-	"fmt"
 	"io"
 	"os"
-	"sort"
-	"time"
 
 	tendermintABCITypes "github.com/tendermint/tendermint/abci/types"
 	tendermintCommon "github.com/tendermint/tendermint/libs/common"
-	tendermintDB "github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/libs/log"
 	tendermintTypes "github.com/tendermint/tendermint/types"
+	tendermintDB "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	paramsClient "github.com/cosmos/cosmos-sdk/x/params/client"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingExported "github.com/cosmos/cosmos-sdk/x/staking/exported"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/commitHub/commitBlockchain/modules/hub/asset"
+	"github.com/commitHub/commitBlockchain/modules/hub/contract"
+	"github.com/commitHub/commitBlockchain/modules/hub/escrow"
+	"github.com/commitHub/commitBlockchain/modules/hub/fiat"
+	"github.com/commitHub/commitBlockchain/modules/hub/reputation"
+	"github.com/commitHub/commitBlockchain/modules/hub/zone"
+	"github.com/commitHub/commitBlockchain/modules/transfer"
+	"github.com/commitHub/commitBlockchain/types"
 )
 
 const applicationName = "CommitHubApplication"
@@ -33,16 +45,42 @@ const applicationName = "CommitHubApplication"
 var DefaultClientHome = os.ExpandEnv("$HOME/.hubClient")
 var DefaultNodeHome = os.ExpandEnv("$HOME/.hubNode")
 
+var ModuleBasics = module.NewBasicManager(
+	genaccounts.AppModuleBasic{},
+	genutil.AppModuleBasic{},
+	auth.AppModuleBasic{},
+	bank.AppModuleBasic{},
+	staking.AppModuleBasic{},
+	mint.AppModuleBasic{},
+	distribution.AppModuleBasic{},
+	gov.NewAppModuleBasic(paramsClient.ProposalHandler, distribution.ProposalHandler),
+	params.AppModuleBasic{},
+	crisis.AppModuleBasic{},
+	slashing.AppModuleBasic{},
+	supply.AppModuleBasic{},
+	asset.AppModuleBasic{},
+	fiat.AppModuleBasic{},
+	contract.AppModuleBasic{},
+	escrow.AppModuleBasic{},
+	reputation.AppModuleBasic{},
+	zone.AppModuleBasic{},
+	transfer.AppModuleBasic{},
+)
+
+var moduleAccountPermissions = map[string][]string{
+	auth.FeeCollectorName:     nil,
+	distribution.ModuleName:   nil,
+	mint.ModuleName:           {supply.Minter},
+	staking.BondedPoolName:    {supply.Burner, supply.Staking},
+	staking.NotBondedPoolName: {supply.Burner, supply.Staking},
+	gov.ModuleName:            {supply.Burner},
+}
+
 func MakeCodec() *codec.Codec {
 	var cdc = codec.New()
-	bank.RegisterCodec(cdc)
-	staking.RegisterCodec(cdc)
-	distribution.RegisterCodec(cdc)
-	slashing.RegisterCodec(cdc)
-	gov.RegisterCodec(cdc)
-	auth.RegisterCodec(cdc)
-	crisis.RegisterCodec(cdc)
+	ModuleBasics.RegisterCodec(cdc)
 	sdkTypes.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
 	codec.RegisterCrypto(cdc)
 	return cdc
 }
@@ -53,29 +91,44 @@ type CommitHubApplication struct {
 
 	invCheckPeriod uint
 
-	keyMain          *sdkTypes.KVStoreKey
-	keyAccount       *sdkTypes.KVStoreKey
-	keyStaking       *sdkTypes.KVStoreKey
-	tkeyStaking      *sdkTypes.TransientStoreKey
-	keySlashing      *sdkTypes.KVStoreKey
-	keyMint          *sdkTypes.KVStoreKey
-	keyDistribution  *sdkTypes.KVStoreKey
-	tkeyDistribution *sdkTypes.TransientStoreKey
-	keyGov           *sdkTypes.KVStoreKey
-	keyFeeCollection *sdkTypes.KVStoreKey
-	keyParameter     *sdkTypes.KVStoreKey
-	tkeyParameter    *sdkTypes.TransientStoreKey
-
-	accountKeeper       auth.AccountKeeper
-	feeCollectionKeeper auth.FeeCollectionKeeper
-	bankKeeper          bank.Keeper
-	stakingKeeper       staking.Keeper
-	slashingKeeper      slashing.Keeper
-	mintKeeper          mint.Keeper
-	distributionKeeper  distribution.Keeper
-	govKeeper           gov.Keeper
-	crisisKeeper        crisis.Keeper
-	parameterKeeper     params.Keeper
+	keyMain         *sdkTypes.KVStoreKey
+	keyAccount      *sdkTypes.KVStoreKey
+	keySupply       *sdkTypes.KVStoreKey
+	keyStaking      *sdkTypes.KVStoreKey
+	tkeyStaking     *sdkTypes.TransientStoreKey
+	keySlashing     *sdkTypes.KVStoreKey
+	keyMint         *sdkTypes.KVStoreKey
+	keyDistribution *sdkTypes.KVStoreKey
+	keyGov          *sdkTypes.KVStoreKey
+	keyParameter    *sdkTypes.KVStoreKey
+	tkeyParameter   *sdkTypes.TransientStoreKey
+	keyAsset        *sdkTypes.KVStoreKey
+	keyFiat         *sdkTypes.KVStoreKey
+	keyContract     *sdkTypes.KVStoreKey
+	keyEscrow       *sdkTypes.KVStoreKey
+	keyReputation   *sdkTypes.KVStoreKey
+	keyZone         *sdkTypes.KVStoreKey
+	keyTransfer     *sdkTypes.KVStoreKey
+
+	accountKeeper      auth.AccountKeeper
+	bankKeeper         bank.Keeper
+	supplyKeeper       supply.Keeper
+	stakingKeeper      staking.Keeper
+	slashingKeeper     slashing.Keeper
+	mintKeeper         mint.Keeper
+	distributionKeeper distribution.Keeper
+	govKeeper          gov.Keeper
+	crisisKeeper       crisis.Keeper
+	parameterKeeper    params.Keeper
+	assetKeeper        asset.Keeper
+	fiatKeeper         fiat.Keeper
+	contractKeeper     contract.Keeper
+	escrowKeeper       escrow.Keeper
+	reputationKeeper   reputation.Keeper
+	zoneKeeper         zone.Keeper
+	transferKeeper     transfer.Keeper
+
+	moduleManager *module.Manager
 }
 
 func NewCommitHubApplication(logger log.Logger, db tendermintDB.DB, traceStore io.Writer, loadLatest bool, invCheckPeriod uint, baseAppOptions ...func(*baseapp.BaseApp)) *CommitHubApplication {
@@ -86,27 +139,34 @@ func NewCommitHubApplication(logger log.Logger, db tendermintDB.DB, traceStore i
 	baseApp.SetCommitMultiStoreTracer(traceStore)
 
 	var application = &CommitHubApplication{
-		BaseApp:          baseApp,
-		cdc:              cdc,
-		invCheckPeriod:   invCheckPeriod,
-		keyMain:          sdkTypes.NewKVStoreKey(baseapp.MainStoreKey),
-		keyAccount:       sdkTypes.NewKVStoreKey(auth.StoreKey),
-		keyStaking:       sdkTypes.NewKVStoreKey(staking.StoreKey),
-		tkeyStaking:      sdkTypes.NewTransientStoreKey(staking.TStoreKey),
-		keyMint:          sdkTypes.NewKVStoreKey(mint.StoreKey),
-		keyDistribution:  sdkTypes.NewKVStoreKey(distribution.StoreKey),
-		tkeyDistribution: sdkTypes.NewTransientStoreKey(distribution.TStoreKey),
-		keySlashing:      sdkTypes.NewKVStoreKey(slashing.StoreKey),
-		keyGov:           sdkTypes.NewKVStoreKey(gov.StoreKey),
-		keyFeeCollection: sdkTypes.NewKVStoreKey(auth.FeeStoreKey),
-		keyParameter:     sdkTypes.NewKVStoreKey(params.StoreKey),
-		tkeyParameter:    sdkTypes.NewTransientStoreKey(params.TStoreKey),
+		BaseApp:         baseApp,
+		cdc:             cdc,
+		invCheckPeriod:  invCheckPeriod,
+		keyMain:         sdkTypes.NewKVStoreKey(baseapp.MainStoreKey),
+		keyAccount:      sdkTypes.NewKVStoreKey(auth.StoreKey),
+		keySupply:       sdkTypes.NewKVStoreKey(supply.StoreKey),
+		keyStaking:      sdkTypes.NewKVStoreKey(staking.StoreKey),
+		tkeyStaking:     sdkTypes.NewTransientStoreKey(staking.TStoreKey),
+		keyMint:         sdkTypes.NewKVStoreKey(mint.StoreKey),
+		keyDistribution: sdkTypes.NewKVStoreKey(distribution.StoreKey),
+		keySlashing:     sdkTypes.NewKVStoreKey(slashing.StoreKey),
+		keyGov:          sdkTypes.NewKVStoreKey(gov.StoreKey),
+		keyParameter:    sdkTypes.NewKVStoreKey(params.StoreKey),
+		tkeyParameter:   sdkTypes.NewTransientStoreKey(params.TStoreKey),
+		keyAsset:        sdkTypes.NewKVStoreKey(asset.StoreKey),
+		keyFiat:         sdkTypes.NewKVStoreKey(fiat.StoreKey),
+		keyContract:     sdkTypes.NewKVStoreKey(contract.StoreKey),
+		keyEscrow:       sdkTypes.NewKVStoreKey(escrow.StoreKey),
+		keyReputation:   sdkTypes.NewKVStoreKey(reputation.StoreKey),
+		keyZone:         sdkTypes.NewKVStoreKey(zone.StoreKey),
+		keyTransfer:     sdkTypes.NewKVStoreKey(transfer.StoreKey),
 	}
 
 	application.parameterKeeper = params.NewKeeper(
 		application.cdc,
 		application.keyParameter,
 		application.tkeyParameter,
+		params.DefaultCodespace,
 	)
 
 	application.accountKeeper = auth.NewAccountKeeper(
@@ -120,30 +180,37 @@ func NewCommitHubApplication(logger log.Logger, db tendermintDB.DB, traceStore i
 		application.accountKeeper,
 		application.parameterKeeper.Subspace(bank.DefaultParamspace),
 		bank.DefaultCodespace,
+		ModuleAccountAddresses(),
 	)
-	application.feeCollectionKeeper = auth.NewFeeCollectionKeeper(
+	application.supplyKeeper = supply.NewKeeper(
 		application.cdc,
-		application.keyFeeCollection,
+		application.keySupply,
+		application.accountKeeper,
+		application.bankKeeper,
+		moduleAccountPermissions,
 	)
 	stakingKeeper := staking.NewKeeper(
 		application.cdc,
 		application.keyStaking,
 		application.tkeyStaking,
-		application.bankKeeper,
+		application.supplyKeeper,
 		application.parameterKeeper.Subspace(staking.DefaultParamspace),
 		staking.DefaultCodespace,
 	)
 	application.mintKeeper = mint.NewKeeper(application.cdc, application.keyMint,
 		application.parameterKeeper.Subspace(mint.DefaultParamspace),
 		&stakingKeeper,
-		application.feeCollectionKeeper,
+		application.supplyKeeper,
+		auth.FeeCollectorName,
 	)
 	application.distributionKeeper = distribution.NewKeeper(
 		application.cdc,
 		application.keyDistribution,
 		application.parameterKeeper.Subspace(distribution.DefaultParamspace),
-		application.bankKeeper, &stakingKeeper, application.feeCollectionKeeper,
+		&stakingKeeper, application.supplyKeeper,
 		distribution.DefaultCodespace,
+		auth.FeeCollectorName,
+		ModuleAccountAddresses(),
 	)
 	application.slashingKeeper = slashing.NewKeeper(
 		application.cdc,
@@ -152,62 +219,160 @@ func NewCommitHubApplication(logger log.Logger, db tendermintDB.DB, traceStore i
 		application.parameterKeeper.Subspace(slashing.DefaultParamspace),
 		slashing.DefaultCodespace,
 	)
+	govRouter := gov.NewRouter().
+		AddRoute(gov.RouterKey, gov.ProposalHandler).
+		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(application.parameterKeeper)).
+		AddRoute(distribution.RouterKey, distribution.NewCommunityPoolSpendProposalHandler(application.distributionKeeper))
 	application.govKeeper = gov.NewKeeper(
 		application.cdc,
 		application.keyGov,
 		application.parameterKeeper,
 		application.parameterKeeper.Subspace(gov.DefaultParamspace),
-		application.bankKeeper, &stakingKeeper,
+		application.supplyKeeper, &stakingKeeper,
 		gov.DefaultCodespace,
+		govRouter,
 	)
 	application.crisisKeeper = crisis.NewKeeper(
 		application.parameterKeeper.Subspace(crisis.DefaultParamspace),
-		application.distributionKeeper,
-		application.bankKeeper,
-		application.feeCollectionKeeper,
+		invCheckPeriod,
+		application.supplyKeeper,
+		auth.FeeCollectorName,
+	)
+	application.assetKeeper = asset.NewKeeper(
+		application.cdc,
+		application.keyAsset,
+		application.parameterKeeper.Subspace(asset.DefaultParamspace),
+		asset.DefaultCodespace,
+	)
+	application.fiatKeeper = fiat.NewKeeper(
+		application.cdc,
+		application.keyFiat,
+		application.parameterKeeper.Subspace(fiat.DefaultParamspace),
+		fiat.DefaultCodespace,
+	)
+	application.contractKeeper = contract.NewKeeper(
+		application.cdc,
+		application.keyContract,
+		application.accountKeeper,
+		application.assetKeeper,
+		contract.DefaultCodespace,
+	)
+	escrowKeeper := escrow.NewKeeper(
+		application.cdc,
+		application.keyEscrow,
+		application.assetKeeper,
+		application.fiatKeeper,
+		application.contractKeeper,
+		escrow.DefaultCodespace,
+	)
+	application.reputationKeeper = reputation.NewKeeper(
+		application.cdc,
+		application.keyReputation,
+		escrowKeeper,
+		application.contractKeeper,
+		reputation.DefaultCodespace,
+	)
+	application.zoneKeeper = zone.NewKeeper(
+		application.cdc,
+		application.keyZone,
+		application.parameterKeeper.Subspace(zone.DefaultParamspace),
+		zone.DefaultCodespace,
 	)
+	application.transferKeeper = transfer.NewKeeper(
+		application.cdc,
+		application.keyTransfer,
+		application.zoneKeeper,
+		transfer.DefaultCodespace,
+	).
+		AddPegHandler(transfer.PegTypeAsset, application.assetKeeper).
+		AddPegHandler(transfer.PegTypeFiat, application.fiatKeeper)
 	application.stakingKeeper = *stakingKeeper.SetHooks(
-		NewStakingHooks(application.distributionKeeper.Hooks(), application.slashingKeeper.Hooks()),
+		staking.NewMultiStakingHooks(application.distributionKeeper.Hooks(), application.slashingKeeper.Hooks()),
+	)
+	application.escrowKeeper = *escrowKeeper.SetHooks(application.reputationKeeper.Hooks())
+
+	application.moduleManager = module.NewManager(
+		genaccounts.NewAppModule(application.accountKeeper),
+		genutil.NewAppModule(application.accountKeeper, application.stakingKeeper, application.BaseApp.DeliverTx),
+		auth.NewAppModule(application.accountKeeper),
+		bank.NewAppModule(application.bankKeeper, application.accountKeeper),
+		crisis.NewAppModule(&application.crisisKeeper),
+		supply.NewAppModule(application.supplyKeeper, application.accountKeeper),
+		distribution.NewAppModule(application.distributionKeeper, application.supplyKeeper),
+		gov.NewAppModule(application.govKeeper, application.supplyKeeper),
+		mint.NewAppModule(application.mintKeeper),
+		slashing.NewAppModule(application.slashingKeeper, application.stakingKeeper),
+		staking.NewAppModule(application.stakingKeeper, application.distributionKeeper, application.accountKeeper, application.supplyKeeper),
+		asset.NewAppModule(application.assetKeeper),
+		fiat.NewAppModule(application.fiatKeeper),
+		contract.NewAppModule(application.contractKeeper),
+		escrow.NewAppModule(application.escrowKeeper),
+		reputation.NewAppModule(application.reputationKeeper),
+		zone.NewAppModule(application.zoneKeeper),
+		transfer.NewAppModule(application.transferKeeper),
+	)
+
+	application.moduleManager.SetOrderBeginBlockers(
+		mint.ModuleName,
+		distribution.ModuleName,
+		slashing.ModuleName,
 	)
 
-	bank.RegisterInvariants(&application.crisisKeeper, application.accountKeeper)
-	distribution.RegisterInvariants(&application.crisisKeeper, application.distributionKeeper, application.stakingKeeper)
-	staking.RegisterInvariants(&application.crisisKeeper, application.stakingKeeper, application.feeCollectionKeeper, application.distributionKeeper, application.accountKeeper)
-
-	application.Router().
-		AddRoute(bank.RouterKey, bank.NewHandler(application.bankKeeper)).
-		AddRoute(staking.RouterKey, staking.NewHandler(application.stakingKeeper)).
-		AddRoute(distribution.RouterKey, distribution.NewHandler(application.distributionKeeper)).
-		AddRoute(slashing.RouterKey, slashing.NewHandler(application.slashingKeeper)).
-		AddRoute(gov.RouterKey, gov.NewHandler(application.govKeeper)).
-		AddRoute(crisis.RouterKey, crisis.NewHandler(application.crisisKeeper))
-
-	application.QueryRouter().
-		AddRoute(auth.QuerierRoute, auth.NewQuerier(application.accountKeeper)).
-		AddRoute(distribution.QuerierRoute, distribution.NewQuerier(application.distributionKeeper)).
-		AddRoute(gov.QuerierRoute, gov.NewQuerier(application.govKeeper)).
-		AddRoute(slashing.QuerierRoute, slashing.NewQuerier(application.slashingKeeper, application.cdc)).
-		AddRoute(staking.QuerierRoute, staking.NewQuerier(application.stakingKeeper, application.cdc)).
-		AddRoute(mint.QuerierRoute, mint.NewQuerier(application.mintKeeper))
+	application.moduleManager.SetOrderEndBlockers(
+		crisis.ModuleName,
+		gov.ModuleName,
+		staking.ModuleName,
+		escrow.ModuleName,
+	)
+
+	application.moduleManager.SetOrderInitGenesis(
+		genaccounts.ModuleName,
+		distribution.ModuleName,
+		staking.ModuleName,
+		auth.ModuleName,
+		bank.ModuleName,
+		slashing.ModuleName,
+		gov.ModuleName,
+		mint.ModuleName,
+		supply.ModuleName,
+		crisis.ModuleName,
+		asset.ModuleName,
+		fiat.ModuleName,
+		contract.ModuleName,
+		escrow.ModuleName,
+		reputation.ModuleName,
+		zone.ModuleName,
+		transfer.ModuleName,
+		genutil.ModuleName,
+	)
+
+	application.moduleManager.RegisterInvariants(&application.crisisKeeper)
+	application.moduleManager.RegisterRoutes(application.Router(), application.QueryRouter())
 
 	application.MountStores(
 		application.keyMain,
 		application.keyAccount,
+		application.keySupply,
 		application.keyStaking,
 		application.keyMint,
 		application.keyDistribution,
 		application.keySlashing,
 		application.keyGov,
-		application.keyFeeCollection,
 		application.keyParameter,
+		application.keyAsset,
+		application.keyFiat,
+		application.keyContract,
+		application.keyEscrow,
+		application.keyReputation,
+		application.keyZone,
+		application.keyTransfer,
 		application.tkeyParameter,
 		application.tkeyStaking,
-		application.tkeyDistribution,
 	)
 
-	application.SetInitChainer(application.initChainer)
+	application.SetInitChainer(application.InitChainer)
 	application.SetBeginBlocker(application.BeginBlocker)
-	application.SetAnteHandler(auth.NewAnteHandler(application.accountKeeper, application.feeCollectionKeeper))
+	application.SetAnteHandler(auth.NewAnteHandler(application.accountKeeper, application.supplyKeeper, auth.DefaultSigVerificationGasConsumer))
 	application.SetEndBlocker(application.EndBlocker)
 
 	if loadLatest {
@@ -219,121 +384,16 @@ func NewCommitHubApplication(logger log.Logger, db tendermintDB.DB, traceStore i
 
 	return application
 }
-func (commitHubApplication *CommitHubApplication) assertRuntimeInvariants() {
-	context := commitHubApplication.NewContext(false, tendermintABCITypes.Header{Height: commitHubApplication.LastBlockHeight() + 1})
-	commitHubApplication.assertRuntimeInvariantsOnContext(context)
-}
-func (commitHubApplication *CommitHubApplication) assertRuntimeInvariantsOnContext(ctx sdkTypes.Context) {
-	start := time.Now()
-	invarRoutes := commitHubApplication.crisisKeeper.Routes()
-	for _, ir := range invarRoutes {
-		if err := ir.Invar(ctx); err != nil {
-			panic(fmt.Errorf("invariant broken: %s\n"+
-				"\tCRITICAL please submit the following transaction:\n"+
-				"\t\t gaiacli tx crisis invariant-broken %v %v", err, ir.ModuleName, ir.Route))
-		}
-	}
-	end := time.Now()
-	diff := end.Sub(start)
-	commitHubApplication.BaseApp.Logger().With("module", "invariants").Info(
-		"Asserted all invariants", "duration", diff, "height", commitHubApplication.LastBlockHeight())
-}
 func (commitHubApplication *CommitHubApplication) BeginBlocker(ctx sdkTypes.Context, req tendermintABCITypes.RequestBeginBlock) tendermintABCITypes.ResponseBeginBlock {
-	mint.BeginBlocker(ctx, commitHubApplication.mintKeeper)
-	distribution.BeginBlocker(ctx, req, commitHubApplication.distributionKeeper)
-	tags := slashing.BeginBlocker(ctx, req, commitHubApplication.slashingKeeper)
-	return tendermintABCITypes.ResponseBeginBlock{
-		Tags: tags.ToKVPairs(),
-	}
+	return commitHubApplication.moduleManager.BeginBlock(ctx, req)
 }
 func (commitHubApplication *CommitHubApplication) EndBlocker(ctx sdkTypes.Context, req tendermintABCITypes.RequestEndBlock) tendermintABCITypes.ResponseEndBlock {
-	tags := gov.EndBlocker(ctx, commitHubApplication.govKeeper)
-	validatorUpdates, endBlockerTags := staking.EndBlocker(ctx, commitHubApplication.stakingKeeper)
-	tags = append(tags, endBlockerTags...)
-
-	if commitHubApplication.invCheckPeriod != 0 && ctx.BlockHeight()%int64(commitHubApplication.invCheckPeriod) == 0 {
-		commitHubApplication.assertRuntimeInvariants()
-	}
-
-	return tendermintABCITypes.ResponseEndBlock{
-		ValidatorUpdates: validatorUpdates,
-		Tags:             tags,
-	}
-}
-func (commitHubApplication *CommitHubApplication) initializeFromGenesisState(ctx sdkTypes.Context, genesisState GenesisState) []tendermintABCITypes.ValidatorUpdate {
-	genesisState.Sanitize()
-
-	for _, genesisStateAccount := range genesisState.Accounts {
-		account := genesisStateAccount.ToAccount()
-		account = commitHubApplication.accountKeeper.NewAccount(ctx, account)
-		commitHubApplication.accountKeeper.SetAccount(ctx, account)
-	}
-
-	distribution.InitGenesis(ctx, commitHubApplication.distributionKeeper, genesisState.DistributionData)
-
-	validators, err := staking.InitGenesis(ctx, commitHubApplication.stakingKeeper, genesisState.StakingData)
-	if err != nil {
-		panic(err)
-	}
-
-	auth.InitGenesis(ctx, commitHubApplication.accountKeeper, commitHubApplication.feeCollectionKeeper, genesisState.AuthData)
-	bank.InitGenesis(ctx, commitHubApplication.bankKeeper, genesisState.BankData)
-	slashing.InitGenesis(ctx, commitHubApplication.slashingKeeper, genesisState.SlashingData, genesisState.StakingData.Validators.ToSDKValidators())
-	gov.InitGenesis(ctx, commitHubApplication.govKeeper, genesisState.GovernmentData)
-	crisis.InitGenesis(ctx, commitHubApplication.crisisKeeper, genesisState.CrisisData)
-	mint.InitGenesis(ctx, commitHubApplication.mintKeeper, genesisState.MintData)
-
-	if err := ValidateGenesisState(genesisState); err != nil {
-		panic(err)
-	}
-
-	if len(genesisState.GenesisTransactions) > 0 {
-		for _, genesisTransactions := range genesisState.GenesisTransactions {
-			var tx auth.StdTx
-			err = commitHubApplication.cdc.UnmarshalJSON(genesisTransactions, &tx)
-			if err != nil {
-				panic(err)
-			}
-			bz := commitHubApplication.cdc.MustMarshalBinaryLengthPrefixed(tx)
-			res := commitHubApplication.BaseApp.DeliverTx(bz)
-			if !res.IsOK() {
-				panic(res.Log)
-			}
-		}
-		validators = commitHubApplication.stakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
-	}
-	return validators
+	return commitHubApplication.moduleManager.EndBlock(ctx, req)
 }
-func (commitHubApplication *CommitHubApplication) initChainer(ctx sdkTypes.Context, req tendermintABCITypes.RequestInitChain) tendermintABCITypes.ResponseInitChain {
-	stateJSON := req.AppStateBytes
-
+func (commitHubApplication *CommitHubApplication) InitChainer(ctx sdkTypes.Context, req tendermintABCITypes.RequestInitChain) tendermintABCITypes.ResponseInitChain {
 	var genesisState GenesisState
-	err := commitHubApplication.cdc.UnmarshalJSON(stateJSON, &genesisState)
-	if err != nil {
-		panic(err)
-	}
-
-	validators := commitHubApplication.initializeFromGenesisState(ctx, genesisState)
-
-	if len(req.Validators) > 0 {
-		if len(req.Validators) != len(validators) {
-			panic(fmt.Errorf("len(RequestInitChain.Validators) != len(validators) (%d != %d)",
-				len(req.Validators), len(validators)))
-		}
-		sort.Sort(tendermintABCITypes.ValidatorUpdates(req.Validators))
-		sort.Sort(tendermintABCITypes.ValidatorUpdates(validators))
-		for i, val := range validators {
-			if !val.Equal(req.Validators[i]) {
-				panic(fmt.Errorf("validators[%d] != req.Validators[%d] ", i, i))
-			}
-		}
-	}
-
-	commitHubApplication.assertRuntimeInvariants()
-
-	return tendermintABCITypes.ResponseInitChain{
-		Validators: validators,
-	}
+	commitHubApplication.cdc.MustUnmarshalJSON(req.AppStateBytes, &genesisState)
+	return commitHubApplication.moduleManager.InitGenesis(ctx, genesisState)
 }
 func (commitHubApplication *CommitHubApplication) LoadHeight(height int64) error {
 	return commitHubApplication.LoadVersion(height, commitHubApplication.keyMain)
@@ -347,26 +407,13 @@ func (commitHubApplication *CommitHubApplication) ExportApplicationStateAndValid
 		commitHubApplication.prepareForZeroHeightGenesis(ctx, jailWhiteList)
 	}
 
-	accounts := []GenesisAccount{}
-	appendAccount := func(acc auth.Account) (stop bool) {
-		account := NewGenesisAccountI(acc)
-		accounts = append(accounts, account)
-		return false
+	genesisState := commitHubApplication.moduleManager.ExportGenesis(ctx)
+	for moduleName, moduleGenesisState := range genesisState {
+		if moduleGenesisState == nil {
+			genesisState[moduleName] = ModuleBasics[moduleName].DefaultGenesis()
+		}
 	}
-	commitHubApplication.accountKeeper.IterateAccounts(ctx, appendAccount)
-
-	genState := NewGenesisState(
-		accounts,
-		auth.ExportGenesis(ctx, commitHubApplication.accountKeeper, commitHubApplication.feeCollectionKeeper),
-		bank.ExportGenesis(ctx, commitHubApplication.bankKeeper),
-		staking.ExportGenesis(ctx, commitHubApplication.stakingKeeper),
-		mint.ExportGenesis(ctx, commitHubApplication.mintKeeper),
-		distribution.ExportGenesis(ctx, commitHubApplication.distributionKeeper),
-		gov.ExportGenesis(ctx, commitHubApplication.govKeeper),
-		crisis.ExportGenesis(ctx, commitHubApplication.crisisKeeper),
-		slashing.ExportGenesis(ctx, commitHubApplication.slashingKeeper),
-	)
-	appState, err = codec.MarshalJSONIndent(commitHubApplication.cdc, genState)
+	appState, err = codec.MarshalJSONIndent(commitHubApplication.cdc, genesisState)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -390,9 +437,9 @@ func (commitHubApplication *CommitHubApplication) prepareForZeroHeightGenesis(ct
 		whiteListMap[addr] = true
 	}
 
-	commitHubApplication.assertRuntimeInvariantsOnContext(ctx)
+	commitHubApplication.crisisKeeper.AssertInvariants(ctx)
 
-	commitHubApplication.stakingKeeper.IterateValidators(ctx, func(_ int64, val sdkTypes.Validator) (stop bool) {
+	commitHubApplication.stakingKeeper.IterateValidators(ctx, func(_ int64, val stakingExported.ValidatorI) (stop bool) {
 		_, _ = commitHubApplication.distributionKeeper.WithdrawValidatorCommission(ctx, val.GetOperator())
 		return false
 	})
@@ -409,7 +456,7 @@ func (commitHubApplication *CommitHubApplication) prepareForZeroHeightGenesis(ct
 	height := ctx.BlockHeight()
 	ctx = ctx.WithBlockHeight(0)
 
-	commitHubApplication.stakingKeeper.IterateValidators(ctx, func(_ int64, val sdkTypes.Validator) (stop bool) {
+	commitHubApplication.stakingKeeper.IterateValidators(ctx, func(_ int64, val stakingExported.ValidatorI) (stop bool) {
 
 		scraps := commitHubApplication.distributionKeeper.GetValidatorOutstandingRewards(ctx, val.GetOperator())
 		feePool := commitHubApplication.distributionKeeper.GetFeePool(ctx)
@@ -447,7 +494,6 @@ func (commitHubApplication *CommitHubApplication) prepareForZeroHeightGenesis(ct
 	iter := sdkTypes.KVStoreReversePrefixIterator(store, staking.ValidatorsKey)
 	counter := int16(0)
 
-	var valConsAddrs []sdkTypes.ConsAddress
 	for ; iter.Valid(); iter.Next() {
 		addr := sdkTypes.ValAddress(iter.Key()[1:])
 		validator, found := commitHubApplication.stakingKeeper.GetValidator(ctx, addr)
@@ -456,7 +502,6 @@ func (commitHubApplication *CommitHubApplication) prepareForZeroHeightGenesis(ct
 		}
 
 		validator.UnbondingHeight = 0
-		valConsAddrs = append(valConsAddrs, validator.ConsAddress())
 		if applyWhiteList && !whiteListMap[addr.String()] {
 			validator.Jailed = true
 		}
@@ -478,54 +523,10 @@ func (commitHubApplication *CommitHubApplication) prepareForZeroHeightGenesis(ct
 		},
 	)
 }
-
-var _ sdkTypes.StakingHooks = StakingHooks{}
-
-type StakingHooks struct {
-	distributionHooks distribution.Hooks
-	stakingHooks      slashing.Hooks
-}
-
-func NewStakingHooks(distributionHooks distribution.Hooks, stakingHooks slashing.Hooks) StakingHooks {
-	return StakingHooks{distributionHooks, stakingHooks}
-}
-func (stakingHooks StakingHooks) AfterValidatorCreated(ctx sdkTypes.Context, valAddr sdkTypes.ValAddress) {
-	stakingHooks.distributionHooks.AfterValidatorCreated(ctx, valAddr)
-	stakingHooks.stakingHooks.AfterValidatorCreated(ctx, valAddr)
-}
-func (stakingHooks StakingHooks) BeforeValidatorModified(ctx sdkTypes.Context, valAddr sdkTypes.ValAddress) {
-	stakingHooks.distributionHooks.BeforeValidatorModified(ctx, valAddr)
-	stakingHooks.stakingHooks.BeforeValidatorModified(ctx, valAddr)
-}
-func (stakingHooks StakingHooks) AfterValidatorRemoved(ctx sdkTypes.Context, consAddr sdkTypes.ConsAddress, valAddr sdkTypes.ValAddress) {
-	stakingHooks.distributionHooks.AfterValidatorRemoved(ctx, consAddr, valAddr)
-	stakingHooks.stakingHooks.AfterValidatorRemoved(ctx, consAddr, valAddr)
-}
-func (stakingHooks StakingHooks) AfterValidatorBonded(ctx sdkTypes.Context, consAddr sdkTypes.ConsAddress, valAddr sdkTypes.ValAddress) {
-	stakingHooks.distributionHooks.AfterValidatorBonded(ctx, consAddr, valAddr)
-	stakingHooks.stakingHooks.AfterValidatorBonded(ctx, consAddr, valAddr)
-}
-func (stakingHooks StakingHooks) AfterValidatorBeginUnbonding(ctx sdkTypes.Context, consAddr sdkTypes.ConsAddress, valAddr sdkTypes.ValAddress) {
-	stakingHooks.distributionHooks.AfterValidatorBeginUnbonding(ctx, consAddr, valAddr)
-	stakingHooks.stakingHooks.AfterValidatorBeginUnbonding(ctx, consAddr, valAddr)
-}
-func (stakingHooks StakingHooks) BeforeDelegationCreated(ctx sdkTypes.Context, delAddr sdkTypes.AccAddress, valAddr sdkTypes.ValAddress) {
-	stakingHooks.distributionHooks.BeforeDelegationCreated(ctx, delAddr, valAddr)
-	stakingHooks.stakingHooks.BeforeDelegationCreated(ctx, delAddr, valAddr)
-}
-func (stakingHooks StakingHooks) BeforeDelegationSharesModified(ctx sdkTypes.Context, delAddr sdkTypes.AccAddress, valAddr sdkTypes.ValAddress) {
-	stakingHooks.distributionHooks.BeforeDelegationSharesModified(ctx, delAddr, valAddr)
-	stakingHooks.stakingHooks.BeforeDelegationSharesModified(ctx, delAddr, valAddr)
-}
-func (stakingHooks StakingHooks) BeforeDelegationRemoved(ctx sdkTypes.Context, delAddr sdkTypes.AccAddress, valAddr sdkTypes.ValAddress) {
-	stakingHooks.distributionHooks.BeforeDelegationRemoved(ctx, delAddr, valAddr)
-	stakingHooks.stakingHooks.BeforeDelegationRemoved(ctx, delAddr, valAddr)
-}
-func (stakingHooks StakingHooks) AfterDelegationModified(ctx sdkTypes.Context, delAddr sdkTypes.AccAddress, valAddr sdkTypes.ValAddress) {
-	stakingHooks.distributionHooks.AfterDelegationModified(ctx, delAddr, valAddr)
-	stakingHooks.stakingHooks.AfterDelegationModified(ctx, delAddr, valAddr)
-}
-func (stakingHooks StakingHooks) BeforeValidatorSlashed(ctx sdkTypes.Context, valAddr sdkTypes.ValAddress, fraction sdkTypes.Dec) {
-	stakingHooks.distributionHooks.BeforeValidatorSlashed(ctx, valAddr, fraction)
-	stakingHooks.stakingHooks.BeforeValidatorSlashed(ctx, valAddr, fraction)
+func ModuleAccountAddresses() map[string]bool {
+	moduleAccountAddresses := make(map[string]bool)
+	for moduleAccountName := range moduleAccountPermissions {
+		moduleAccountAddresses[supply.NewModuleAddress(moduleAccountName).String()] = true
+	}
+	return moduleAccountAddresses
 }
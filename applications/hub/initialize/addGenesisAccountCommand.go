@@ -8,12 +8,13 @@ import (
 	"github.com/tendermint/tendermint/libs/cli"
 	"github.com/tendermint/tendermint/libs/common"
 
-	"github.com/cosmos/cosmos-sdk/client/keys"
-	"github.com/cosmos/cosmos-sdk/cmd/gaia/app"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+
+	"github.com/commitHub/commitBlockchain/applications/hub"
 )
 
 func AddGenesisAccountCommand(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
@@ -25,19 +26,9 @@ func AddGenesisAccountCommand(ctx *server.Context, cdc *codec.Codec) *cobra.Comm
 			config := ctx.Config
 			config.SetRoot(viper.GetString(cli.HomeFlag))
 
-			addr, err := sdk.AccAddressFromBech32(args[0])
+			addr, err := getAddress(args[0])
 			if err != nil {
-				kb, err := keys.NewKeyBaseFromDir(viper.GetString(flagClientHome))
-				if err != nil {
-					return err
-				}
-
-				info, err := kb.Get(args[0])
-				if err != nil {
-					return err
-				}
-
-				addr = info.GetAddress()
+				return err
 			}
 
 			coins, err := sdk.ParseCoins(args[1])
@@ -54,7 +45,7 @@ func AddGenesisAccountCommand(ctx *server.Context, cdc *codec.Codec) *cobra.Comm
 
 			genFile := config.GenesisFile()
 			if !common.FileExists(genFile) {
-				return fmt.Errorf("%s does not exist, run `gaiad init` first", genFile)
+				return fmt.Errorf("%s does not exist, run `hubNode initialize` first", genFile)
 			}
 
 			genDoc, err := LoadGenesisDoc(cdc, genFile)
@@ -62,7 +53,7 @@ func AddGenesisAccountCommand(ctx *server.Context, cdc *codec.Codec) *cobra.Comm
 				return err
 			}
 
-			var appState app.GenesisState
+			var appState hub.GenesisState
 			if err = cdc.UnmarshalJSON(genDoc.AppState, &appState); err != nil {
 				return err
 			}
@@ -81,8 +72,8 @@ func AddGenesisAccountCommand(ctx *server.Context, cdc *codec.Codec) *cobra.Comm
 		},
 	}
 
-	cmd.Flags().String(cli.HomeFlag, app.DefaultNodeHome, "node's home directory")
-	cmd.Flags().String(flagClientHome, app.DefaultCLIHome, "client's home directory")
+	cmd.Flags().String(cli.HomeFlag, hub.DefaultNodeHome, "node's home directory")
+	cmd.Flags().String(flagClientHome, hub.DefaultClientHome, "client's home directory")
 	cmd.Flags().String(flagVestingAmt, "", "amount of coins for vesting accounts")
 	cmd.Flags().Uint64(flagVestingStart, 0, "schedule start time (unix epoch) for vesting accounts")
 	cmd.Flags().Uint64(flagVestingEnd, 0, "schedule end time (unix epoch) for vesting accounts")
@@ -91,14 +82,13 @@ func AddGenesisAccountCommand(ctx *server.Context, cdc *codec.Codec) *cobra.Comm
 }
 
 func addGenesisAccount(
-	cdc *codec.Codec, appState app.GenesisState, addr sdk.AccAddress,
+	cdc *codec.Codec, appState hub.GenesisState, addr sdk.AccAddress,
 	coins, vestingAmt sdk.Coins, vestingStart, vestingEnd int64,
-) (app.GenesisState, error) {
+) (hub.GenesisState, error) {
 
-	for _, stateAcc := range appState.Accounts {
-		if stateAcc.Address.Equals(addr) {
-			return appState, fmt.Errorf("the application state already contains account %v", addr)
-		}
+	genesisAccounts := genaccounts.GetGenesisStateFromAppState(cdc, appState)
+	if genaccounts.GenesisAccounts(genesisAccounts).Contains(addr) {
+		return appState, fmt.Errorf("the application state already contains account %v", addr)
 	}
 
 	acc := auth.NewBaseAccountWithAddress(addr)
@@ -131,10 +121,14 @@ func addGenesisAccount(
 			}
 		}
 
-		appState.Accounts = append(appState.Accounts, app.NewGenesisAccountI(vacc))
+		genesisAccount, err := genaccounts.NewGenesisAccountI(vacc)
+		if err != nil {
+			return appState, err
+		}
+		genesisAccounts = append(genesisAccounts, genesisAccount)
 	} else {
-		appState.Accounts = append(appState.Accounts, app.NewGenesisAccount(&acc))
+		genesisAccounts = append(genesisAccounts, genaccounts.NewGenesisAccount(&acc))
 	}
 
-	return appState, nil
+	return genaccounts.SetGenesisStateInAppState(cdc, appState, genesisAccounts), nil
 }
@@ -0,0 +1,129 @@
+package initialize
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+	"github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+
+	"github.com/commitHub/commitBlockchain/applications/hub"
+	"github.com/commitHub/commitBlockchain/modules/hub/asset"
+	commitTypes "github.com/commitHub/commitBlockchain/types"
+)
+
+const (
+	flagPegHash = "peg-hash"
+	flagIssuer  = "issuer"
+)
+
+func AddGenesisAssetCommand(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-genesis-asset [owner_address_or_key_name] [document-hash] [asset-type] [asset-quantity] [quantity-unit]",
+		Short: "Add genesis asset peg to genesis.json",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(_ *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			ownerAddress, err := getAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			assetQuantity, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			issuerAddress := ownerAddress
+			if issuer := viper.GetString(flagIssuer); issuer != "" {
+				if issuerAddress, err = getAddress(issuer); err != nil {
+					return err
+				}
+			}
+
+			genFile := config.GenesisFile()
+			if !common.FileExists(genFile) {
+				return fmt.Errorf("%s does not exist, run `hubNode initialize` first", genFile)
+			}
+
+			genDoc, err := LoadGenesisDoc(cdc, genFile)
+			if err != nil {
+				return err
+			}
+
+			var appState hub.GenesisState
+			if err = cdc.UnmarshalJSON(genDoc.AppState, &appState); err != nil {
+				return err
+			}
+
+			appState, err = addGenesisAsset(cdc, appState, ownerAddress, issuerAddress, viper.GetString(flagPegHash), args[1], args[2], assetQuantity, args[4])
+			if err != nil {
+				return err
+			}
+
+			appStateJSON, err := cdc.MarshalJSON(appState)
+			if err != nil {
+				return err
+			}
+
+			return ExportGenesisFile(genFile, genDoc.ChainID, nil, appStateJSON)
+		},
+	}
+
+	cmd.Flags().String(cli.HomeFlag, hub.DefaultNodeHome, "node's home directory")
+	cmd.Flags().String(flagClientHome, hub.DefaultClientHome, "client's home directory")
+	cmd.Flags().String(flagPegHash, "", "hex peg hash of the existing asset peg, allocated from the peg hash counter if empty")
+	cmd.Flags().String(flagIssuer, "", "address or key name of the asset issuer, defaults to the owner, must be one of the asset params issuers")
+
+	return cmd
+}
+
+func addGenesisAsset(
+	cdc *codec.Codec, appState hub.GenesisState, ownerAddress, issuerAddress sdk.AccAddress,
+	pegHashString, documentHash, assetType string, assetQuantity int64, quantityUnit string,
+) (hub.GenesisState, error) {
+
+	genesisAccounts := genaccounts.GetGenesisStateFromAppState(cdc, appState)
+	if !genaccounts.GenesisAccounts(genesisAccounts).Contains(ownerAddress) {
+		return appState, fmt.Errorf("owner %s is not a genesis account, run `hubNode add-genesis-account` first", ownerAddress)
+	}
+
+	var genesisState asset.GenesisState
+	if err := cdc.UnmarshalJSON(appState[asset.ModuleName], &genesisState); err != nil {
+		return appState, err
+	}
+	if !genesisState.Params.IsIssuer(issuerAddress) {
+		return appState, fmt.Errorf("issuer %s is not in the asset genesis params issuers", issuerAddress)
+	}
+
+	pegHash, pegHashCounter, err := getGenesisPegHash(pegHashString, genesisState.PegHashCounter)
+	if err != nil {
+		return appState, err
+	}
+
+	for _, genesisAssetPeg := range genesisState.AssetPegs {
+		if genesisAssetPeg.AssetPeg.GetPegHash().Equals(pegHash) {
+			return appState, fmt.Errorf("the application state already contains asset peg %v", pegHash)
+		}
+	}
+
+	assetPeg := commitTypes.NewBaseAssetPeg(pegHash, documentHash, assetType, assetQuantity, quantityUnit, ownerAddress)
+	genesisState.PegHashCounter = pegHashCounter
+	genesisState.AssetPegs = append(genesisState.AssetPegs, asset.NewGenesisAssetPeg(&assetPeg, issuerAddress, nil, asset.AssetOrigin{}))
+
+	if err := asset.ValidateGenesis(genesisState); err != nil {
+		return appState, err
+	}
+
+	appState[asset.ModuleName] = cdc.MustMarshalJSON(genesisState)
+	return appState, nil
+}
@@ -0,0 +1,127 @@
+package initialize
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+	"github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+
+	"github.com/commitHub/commitBlockchain/applications/hub"
+	"github.com/commitHub/commitBlockchain/modules/hub/fiat"
+	commitTypes "github.com/commitHub/commitBlockchain/types"
+)
+
+func AddGenesisFiatCommand(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-genesis-fiat [owner_address_or_key_name] [transaction-id] [transaction-amount]",
+		Short: "Add genesis fiat peg to genesis.json",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(_ *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			ownerAddress, err := getAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			transactionAmount, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			issuerAddress := ownerAddress
+			if issuer := viper.GetString(flagIssuer); issuer != "" {
+				if issuerAddress, err = getAddress(issuer); err != nil {
+					return err
+				}
+			}
+
+			genFile := config.GenesisFile()
+			if !common.FileExists(genFile) {
+				return fmt.Errorf("%s does not exist, run `hubNode initialize` first", genFile)
+			}
+
+			genDoc, err := LoadGenesisDoc(cdc, genFile)
+			if err != nil {
+				return err
+			}
+
+			var appState hub.GenesisState
+			if err = cdc.UnmarshalJSON(genDoc.AppState, &appState); err != nil {
+				return err
+			}
+
+			appState, err = addGenesisFiat(cdc, appState, ownerAddress, issuerAddress, viper.GetString(flagPegHash), args[1], transactionAmount)
+			if err != nil {
+				return err
+			}
+
+			appStateJSON, err := cdc.MarshalJSON(appState)
+			if err != nil {
+				return err
+			}
+
+			return ExportGenesisFile(genFile, genDoc.ChainID, nil, appStateJSON)
+		},
+	}
+
+	cmd.Flags().String(cli.HomeFlag, hub.DefaultNodeHome, "node's home directory")
+	cmd.Flags().String(flagClientHome, hub.DefaultClientHome, "client's home directory")
+	cmd.Flags().String(flagPegHash, "", "hex peg hash of the existing fiat peg, allocated from the peg hash counter if empty")
+	cmd.Flags().String(flagIssuer, "", "address or key name of the fiat issuer, defaults to the owner, must be one of the fiat params issuers")
+
+	return cmd
+}
+
+func addGenesisFiat(
+	cdc *codec.Codec, appState hub.GenesisState, ownerAddress, issuerAddress sdk.AccAddress,
+	pegHashString, transactionID string, transactionAmount int64,
+) (hub.GenesisState, error) {
+
+	genesisAccounts := genaccounts.GetGenesisStateFromAppState(cdc, appState)
+	if !genaccounts.GenesisAccounts(genesisAccounts).Contains(ownerAddress) {
+		return appState, fmt.Errorf("owner %s is not a genesis account, run `hubNode add-genesis-account` first", ownerAddress)
+	}
+
+	var genesisState fiat.GenesisState
+	if err := cdc.UnmarshalJSON(appState[fiat.ModuleName], &genesisState); err != nil {
+		return appState, err
+	}
+	if !genesisState.Params.IsIssuer(issuerAddress) {
+		return appState, fmt.Errorf("issuer %s is not in the fiat genesis params issuers", issuerAddress)
+	}
+
+	pegHash, pegHashCounter, err := getGenesisPegHash(pegHashString, genesisState.PegHashCounter)
+	if err != nil {
+		return appState, err
+	}
+
+	for _, genesisFiatPeg := range genesisState.FiatPegs {
+		if genesisFiatPeg.FiatPeg.PegHash.Equals(pegHash) {
+			return appState, fmt.Errorf("the application state already contains fiat peg %v", pegHash)
+		}
+		if genesisFiatPeg.FiatPeg.TransactionID == transactionID {
+			return appState, fmt.Errorf("the application state already contains fiat transaction id %v", transactionID)
+		}
+	}
+
+	fiatPeg := commitTypes.NewBaseFiatPeg(pegHash, transactionID, transactionAmount, ownerAddress)
+	genesisState.PegHashCounter = pegHashCounter
+	genesisState.FiatPegs = append(genesisState.FiatPegs, fiat.NewGenesisFiatPeg(fiatPeg, issuerAddress))
+
+	if err := fiat.ValidateGenesis(genesisState); err != nil {
+		return appState, err
+	}
+
+	appState[fiat.ModuleName] = cdc.MustMarshalJSON(genesisState)
+	return appState, nil
+}
@@ -12,10 +12,11 @@ import (
 	"github.com/tendermint/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
-	"github.com/cosmos/cosmos-sdk/cmd/gaia/app"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/commitHub/commitBlockchain/applications/hub"
 )
 
 const (
@@ -67,7 +68,7 @@ func CollectGenesisTransactionsCommand(ctx *server.Context, cdc *codec.Codec) *c
 		},
 	}
 
-	cmd.Flags().String(cli.HomeFlag, app.DefaultNodeHome, "node's home directory")
+	cmd.Flags().String(cli.HomeFlag, hub.DefaultNodeHome, "node's home directory")
 	cmd.Flags().String(flagGenesisTransactionDirectory, "",
 		"override default \"gentx\" directory from which collect and execute "+
 			"genesis transactions; default [--home]/config/gentx/")
@@ -80,31 +81,20 @@ func generateApplicationStateFromInitialConfiguration(cdc *codec.Codec, config *
 	var (
 		appGenTxs       []auth.StdTx
 		persistentPeers string
-		genTxs          []json.RawMessage
-		jsonRawTx       json.RawMessage
 	)
 
-	appGenTxs, persistentPeers, err = app.CollectStdTxs(
+	appGenTxs, persistentPeers, err = hub.CollectStandardTransacrions(
 		cdc, config.Moniker, initConfiguration.GenTxsDir, genDoc,
 	)
 	if err != nil {
 		return
 	}
 
-	genTxs = make([]json.RawMessage, len(appGenTxs))
 	config.P2P.PersistentPeers = persistentPeers
 
-	for i, stdTx := range appGenTxs {
-		jsonRawTx, err = cdc.MarshalJSON(stdTx)
-		if err != nil {
-			return
-		}
-		genTxs[i] = jsonRawTx
-	}
-
 	cfg.WriteConfigFile(filepath.Join(config.RootDir, "config", "config.toml"), config)
 
-	appState, err = app.GaiaAppGenStateJSON(cdc, genDoc, genTxs)
+	appState, err = hub.CommitHubApplicationGenesiStateJSON(cdc, genDoc, appGenTxs)
 	if err != nil {
 		return
 	}
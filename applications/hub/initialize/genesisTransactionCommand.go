@@ -19,19 +19,21 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/keys"
-	"github.com/cosmos/cosmos-sdk/client/utils"
-	"github.com/cosmos/cosmos-sdk/cmd/gaia/app"
 	"github.com/cosmos/cosmos-sdk/codec"
 	kbkeys "github.com/cosmos/cosmos-sdk/crypto/keys"
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
-	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/staking/client/cli"
+
+	"github.com/commitHub/commitBlockchain/applications/hub"
 )
 
 var (
-	defaultTokens                  = sdk.TokensFromTendermintPower(100)
+	defaultTokens                  = sdk.TokensFromConsensusPower(100)
 	defaultAmount                  = defaultTokens.String() + sdk.DefaultBondDenom
 	defaultCommissionRate          = "0.1"
 	defaultCommissionMaxRate       = "0.2"
@@ -44,7 +46,7 @@ func GenesisTransactionCommand(ctx *server.Context, cdc *codec.Codec) *cobra.Com
 		Use:   "genesisTransaction",
 		Short: "Generate a genesis tx carrying a self delegation",
 		Args:  cobra.NoArgs,
-		Long: fmt.Sprintf(`This command is an alias of the 'gaiad tx create-validator' command'.
+		Long: fmt.Sprintf(`This command is an alias of the 'hubClient tx create-validator' command'.
 It creates a genesis piece carrying a self delegation with the
 following delegation and commission default parameters:
 	delegation amount:           %s
@@ -77,12 +79,12 @@ following delegation and commission default parameters:
 				return err
 			}
 
-			genesisState := app.GenesisState{}
+			genesisState := hub.GenesisState{}
 			if err = cdc.UnmarshalJSON(genDoc.AppState, &genesisState); err != nil {
 				return err
 			}
 
-			if err = app.GaiaValidateGenesisState(genesisState); err != nil {
+			if err = hub.ValidateGenesisState(genesisState); err != nil {
 				return err
 			}
 
@@ -116,12 +118,12 @@ following delegation and commission default parameters:
 				return err
 			}
 
-			err = accountInGenesis(genesisState, key.GetAddress(), coins)
+			err = genutil.ValidateAccountInGenesis(genesisState, genaccounts.AppModuleBasic{}, key.GetAddress(), coins, cdc)
 			if err != nil {
 				return err
 			}
 
-			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
 			viper.Set(client.FlagGenerateOnly, true)
@@ -137,14 +139,14 @@ following delegation and commission default parameters:
 			}
 
 			if info.GetType() == kbkeys.TypeOffline || info.GetType() == kbkeys.TypeMulti {
-				fmt.Println("Offline key passed in. Use `gaiacli tx sign` command to sign:")
-				return utils.PrintUnsignedStdTx(txBldr, cliCtx, []sdk.Msg{msg}, true)
+				fmt.Println("Offline key passed in. Use `hubClient tx sign` command to sign:")
+				return utils.PrintUnsignedStdTx(txBldr, cliCtx, []sdk.Msg{msg})
 			}
 
 			w := bytes.NewBuffer([]byte{})
 			cliCtx = cliCtx.WithOutput(w)
 
-			if err = utils.PrintUnsignedStdTx(txBldr, cliCtx, []sdk.Msg{msg}, true); err != nil {
+			if err = utils.PrintUnsignedStdTx(txBldr, cliCtx, []sdk.Msg{msg}); err != nil {
 				return err
 			}
 
@@ -178,8 +180,8 @@ following delegation and commission default parameters:
 
 	ip, _ := server.ExternalIP()
 
-	cmd.Flags().String(tmcli.HomeFlag, app.DefaultNodeHome, "node's home directory")
-	cmd.Flags().String(flagClientHome, app.DefaultCLIHome, "client's home directory")
+	cmd.Flags().String(tmcli.HomeFlag, hub.DefaultNodeHome, "node's home directory")
+	cmd.Flags().String(flagClientHome, hub.DefaultClientHome, "client's home directory")
 	cmd.Flags().String(client.FlagName, "", "name of private key with which to sign the gentx")
 	cmd.Flags().String(client.FlagOutputDocument, "", "write the genesis transaction JSON document to the given file instead of the default location")
 	cmd.Flags().String(cli.FlagIP, ip, "The node's public IP")
@@ -195,34 +197,6 @@ following delegation and commission default parameters:
 	return cmd
 }
 
-func accountInGenesis(genesisState app.GenesisState, key sdk.AccAddress, coins sdk.Coins) error {
-	accountIsInGenesis := false
-	bondDenom := genesisState.StakingData.Params.BondDenom
-
-	// Check if the account is in genesis
-	for _, acc := range genesisState.Accounts {
-		// Ensure that account is in genesis
-		if acc.Address.Equals(key) {
-
-			// Ensure account contains enough funds of default bond denom
-			if coins.AmountOf(bondDenom).GT(acc.Coins.AmountOf(bondDenom)) {
-				return fmt.Errorf(
-					"account %v is in genesis, but it only has %v%v available to stake, not %v%v",
-					key.String(), acc.Coins.AmountOf(bondDenom), bondDenom, coins.AmountOf(bondDenom), bondDenom,
-				)
-			}
-			accountIsInGenesis = true
-			break
-		}
-	}
-
-	if accountIsInGenesis {
-		return nil
-	}
-
-	return fmt.Errorf("account %s in not in the app_state.accounts array of genesis.json", key)
-}
-
 func prepareFlagsForTxCreateValidator(
 	config *cfg.Config, nodeID, ip, chainID string, valPubKey crypto.PubKey, website, details, identity string,
 ) {
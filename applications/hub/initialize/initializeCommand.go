@@ -59,7 +59,7 @@ func InitializeCommand(ctx *server.Context, cdc *codec.Codec) *cobra.Command { /
 				chainID = fmt.Sprintf("test-chain-%v", common.RandStr(6))
 			}
 
-			nodeID, _, err := initializeNodeValidatorFiles(configuration)
+			nodeID, _, err := InitializeNodeValidatorFiles(configuration)
 			if err != nil {
 				return err
 			}
@@ -73,13 +73,13 @@ func InitializeCommand(ctx *server.Context, cdc *codec.Codec) *cobra.Command { /
 				return err
 			}
 
-			if err = exportGenesisFile(genFile, chainID, nil, appState); err != nil {
+			if err = ExportGenesisFile(genFile, chainID, nil, appState); err != nil {
 				return err
 			}
 
 			toPrint := printInfo{configuration.Moniker, chainID, nodeID, "", appState}
 
-			cfg.WriteConfigFile(filepath.Join(configuration.RootDir, "configuration", "configuration.toml"), configuration)
+			cfg.WriteConfigFile(filepath.Join(configuration.RootDir, "config", "config.toml"), configuration)
 
 			return displayInfo(cdc, toPrint)
 		},
@@ -1,12 +1,14 @@
 package initialize
 
 import (
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"path/filepath"
 	"time"
 
+	"github.com/spf13/viper"
 	"github.com/tendermint/go-amino"
 	cfg "github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/crypto"
@@ -15,9 +17,13 @@ import (
 	"github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/types"
 
-	"github.com/cosmos/cosmos-sdk/cmd/gaia/app"
+	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/applications/hub"
+	commitTypes "github.com/commitHub/commitBlockchain/types"
 )
 
 func ExportGenesisFile(
@@ -96,51 +102,50 @@ func LoadGenesisDoc(cdc *amino.Codec, genFile string) (genDoc types.GenesisDoc,
 	return genDoc, err
 }
 
-func initializeNodeValidatorFiles(config *cfg.Config) (nodeID string, valPubKey crypto.PubKey, err error) {
+func initializeEmptyGenesis(cdc *codec.Codec, genesisFile string, overwrite bool) (appState json.RawMessage, err error) {
 
-	nodeKey, err := p2p.LoadOrGenNodeKey(config.NodeKeyFile())
-	if err != nil {
-		return nodeID, valPubKey, err
+	if !overwrite && common.FileExists(genesisFile) {
+		return nil, fmt.Errorf("genesis.json file already exists: %v", genesisFile)
 	}
 
-	nodeID = string(nodeKey.ID())
-	server.UpgradeOldPrivValFile(config)
+	return codec.MarshalJSONIndent(cdc, hub.NewDefaultGenesisState())
+}
 
-	privateValidatorKeyFile := config.PrivValidatorKeyFile()
-	if err := common.EnsureDir(filepath.Dir(privateValidatorKeyFile), 0777); err != nil {
-		return nodeID, valPubKey, nil
+func getAddress(addressOrKeyName string) (sdk.AccAddress, error) {
+	addr, err := sdk.AccAddressFromBech32(addressOrKeyName)
+	if err == nil {
+		return addr, nil
 	}
 
-	privateValidatorStateFile := config.PrivValidatorStateFile()
-	if err := common.EnsureDir(filepath.Dir(privateValidatorStateFile), 0777); err != nil {
-		return nodeID, valPubKey, nil
+	kb, err := keys.NewKeyBaseFromDir(viper.GetString(flagClientHome))
+	if err != nil {
+		return nil, err
 	}
 
-	valPubKey = privval.LoadOrGenFilePV(privateValidatorKeyFile, privateValidatorStateFile).GetPubKey()
+	info, err := kb.Get(addressOrKeyName)
+	if err != nil {
+		return nil, err
+	}
 
-	return nodeID, valPubKey, nil
+	return info.GetAddress(), nil
 }
 
-func exportGenesisFile(genesisFIle, chainID string, validators []types.GenesisValidator, appState json.RawMessage) error {
-
-	genesisDoc := types.GenesisDoc{
-		ChainID:    chainID,
-		Validators: validators,
-		AppState:   appState,
+func getGenesisPegHash(pegHashString string, pegHashCounter uint64) (commitTypes.PegHash, uint64, error) {
+	if pegHashString == "" {
+		pegHashCounter++
+		pegHash := make([]byte, 8)
+		binary.BigEndian.PutUint64(pegHash, pegHashCounter)
+		return pegHash, pegHashCounter, nil
 	}
 
-	if err := genesisDoc.ValidateAndComplete(); err != nil {
-		return err
+	pegHash, err := commitTypes.GetPegHashHex(pegHashString)
+	if err != nil {
+		return nil, pegHashCounter, err
 	}
 
-	return genesisDoc.SaveAs(genesisFIle)
-}
-
-func initializeEmptyGenesis(cdc *codec.Codec, genesisFile string, overwrite bool) (appState json.RawMessage, err error) {
-
-	if !overwrite && common.FileExists(genesisFile) {
-		return nil, fmt.Errorf("genesis.json file already exists: %v", genesisFile)
+	if len(pegHash) == 8 && binary.BigEndian.Uint64(pegHash) > pegHashCounter {
+		pegHashCounter = binary.BigEndian.Uint64(pegHash)
 	}
 
-	return codec.MarshalJSONIndent(cdc, app.NewDefaultGenesisState())
+	return pegHash, pegHashCounter, nil
 }
@@ -4,11 +4,12 @@ import (
 	"fmt"
 	"os"
 
-	"github.com/cosmos/cosmos-sdk/cmd/gaia/app"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/spf13/cobra"
 	"github.com/tendermint/tendermint/types"
+
+	"github.com/commitHub/commitBlockchain/applications/hub"
 )
 
 func ValidateGenesisCommand(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
@@ -34,16 +35,16 @@ func ValidateGenesisCommand(ctx *server.Context, cdc *codec.Codec) *cobra.Comman
 				return fmt.Errorf("Error loading genesis doc from %s: %s", genesis, err.Error())
 			}
 
-			var genstate app.GenesisState
+			var genstate hub.GenesisState
 			if err = cdc.UnmarshalJSON(genDoc.AppState, &genstate); err != nil {
 				return fmt.Errorf("Error unmarshaling genesis doc %s: %s", genesis, err.Error())
 			}
 
-			if err = app.GaiaValidateGenesisState(genstate); err != nil {
+			if err = hub.ValidateGenesisState(genstate); err != nil {
 				return fmt.Errorf("Error validating genesis file %s: %s", genesis, err.Error())
 			}
 
-			fmt.Printf("File at %s is a valid genesis file for gaiad\n", genesis)
+			fmt.Printf("File at %s is a valid genesis file for hubNode\n", genesis)
 			return nil
 		},
 	}
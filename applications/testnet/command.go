@@ -0,0 +1,409 @@
+package testnet
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	tmconfig "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/crypto"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	srvconfig "github.com/cosmos/cosmos-sdk/server/config"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+var (
+	flagNodeDirectoryPrefix = "node-dir-prefix"
+	flagNumberOfValidators  = "v"
+	flagOutputDirectory     = "output-dir"
+	flagNodeDaemonHome      = "node-daemon-home"
+	flagNodeClientHome      = "node-cli-home"
+	flagStartingIPAddress   = "starting-ip-address"
+)
+
+const (
+	nodeDirPerm    = 0755
+	defaultKeyPass = "12345678"
+)
+
+// Configuration holds what differs between the hub and zone testnet commands.
+// SeedGenesisState is called with the genesis accounts already set and grants
+// the validator accounts any chain specific roles; the first one is the admin.
+type Configuration struct {
+	ChainName             string
+	DaemonName            string
+	DefaultNodeDaemonHome string
+	DefaultNodeClientHome string
+	ChainIDPrefix         string
+	DefaultGenesisState   func() map[string]json.RawMessage
+	SeedGenesisState      func(cdc *codec.Codec, appState map[string]json.RawMessage, validatorAddresses []sdk.AccAddress) (map[string]json.RawMessage, error)
+}
+
+func NewConfiguration(
+	chainName string, daemonName string, defaultNodeDaemonHome string, defaultNodeClientHome string, chainIDPrefix string,
+	defaultGenesisState func() map[string]json.RawMessage,
+	seedGenesisState func(cdc *codec.Codec, appState map[string]json.RawMessage, validatorAddresses []sdk.AccAddress) (map[string]json.RawMessage, error),
+) Configuration {
+	return Configuration{
+		ChainName:             chainName,
+		DaemonName:            daemonName,
+		DefaultNodeDaemonHome: defaultNodeDaemonHome,
+		DefaultNodeClientHome: defaultNodeClientHome,
+		ChainIDPrefix:         chainIDPrefix,
+		DefaultGenesisState:   defaultGenesisState,
+		SeedGenesisState:      seedGenesisState,
+	}
+}
+
+func Command(ctx *server.Context, cdc *codec.Codec, configuration Configuration) *cobra.Command {
+
+	command := &cobra.Command{
+		Use:   "testnet",
+		Short: fmt.Sprintf("Initialize files for a %s testnet", configuration.ChainName),
+		Long:  fmt.Sprintf(`testnet will create "v" number of directories and populate each with necessary files (private validator, genesis, config, etc.). Note, strict routability for addresses is turned off in the config file. Example: %s testnet --v 4 --output-dir ./output --starting-ip-address 192.168.10.2`, configuration.DaemonName),
+		RunE: func(_ *cobra.Command, _ []string) error {
+			config := ctx.Config
+			return initializeTestnet(config, cdc, configuration)
+		},
+	}
+
+	command.Flags().Int(flagNumberOfValidators, 4,
+		"Number of validators to initialize the testnet with",
+	)
+	command.Flags().StringP(flagOutputDirectory, "o", "./mytestnet",
+		"Directory to store initialization data for the testnet",
+	)
+	command.Flags().String(flagNodeDirectoryPrefix, "node",
+		"Prefix the directory name for each node with (node results in node0, node1, ...)",
+	)
+	command.Flags().String(flagNodeDaemonHome, configuration.DefaultNodeDaemonHome,
+		"Home directory of the node's daemon configuration",
+	)
+	command.Flags().String(flagNodeClientHome, configuration.DefaultNodeClientHome,
+		"Home directory of the node's cli configuration",
+	)
+	command.Flags().String(flagStartingIPAddress, "192.168.0.1",
+		"Starting IP address (192.168.0.1 results in persistent peers list ID0@192.168.0.1:46656, ID1@192.168.0.2:46656, ...)")
+
+	command.Flags().String(
+		client.FlagChainID, "", "genesis file chain-id, if left blank will be randomly created",
+	)
+	command.Flags().String(
+		server.FlagMinGasPrices, fmt.Sprintf("0.000006%s", sdk.DefaultBondDenom),
+		"Minimum gas prices to accept for transactions; All fees in a tx must meet this minimum (e.g. 0.01photino,0.001stake)",
+	)
+
+	return command
+}
+
+func initializeTestnet(config *tmconfig.Config, cdc *codec.Codec, configuration Configuration) error {
+	var chainID string
+
+	outDir := viper.GetString(flagOutputDirectory)
+	numberOfValidators := viper.GetInt(flagNumberOfValidators)
+
+	chainID = viper.GetString(client.FlagChainID)
+	if chainID == "" {
+		chainID = configuration.ChainIDPrefix + cmn.RandStr(6)
+	}
+
+	monikers := make([]string, numberOfValidators)
+	nodeIDs := make([]string, numberOfValidators)
+	validatorPubKeys := make([]crypto.PubKey, numberOfValidators)
+
+	chainConfig := srvconfig.DefaultConfig()
+	chainConfig.MinGasPrices = viper.GetString(server.FlagMinGasPrices)
+
+	var (
+		genesisAccounts []genaccounts.GenesisAccount
+		genesisFiles    []string
+	)
+
+	for i := 0; i < numberOfValidators; i++ {
+		nodeDirName := fmt.Sprintf("%s%d", viper.GetString(flagNodeDirectoryPrefix), i)
+		nodeDaemonHomeName := viper.GetString(flagNodeDaemonHome)
+		nodeCliHomeName := viper.GetString(flagNodeClientHome)
+		nodeDir := filepath.Join(outDir, nodeDirName, nodeDaemonHomeName)
+		clientDir := filepath.Join(outDir, nodeDirName, nodeCliHomeName)
+		genesisTransactionDirectory := filepath.Join(outDir, "gentxs")
+
+		config.SetRoot(nodeDir)
+
+		err := os.MkdirAll(filepath.Join(nodeDir, "config"), nodeDirPerm)
+		if err != nil {
+			_ = os.RemoveAll(outDir)
+			return err
+		}
+
+		err = os.MkdirAll(clientDir, nodeDirPerm)
+		if err != nil {
+			_ = os.RemoveAll(outDir)
+			return err
+		}
+
+		monikers[i] = nodeDirName
+		config.Moniker = nodeDirName
+
+		ip, err := getIP(i, viper.GetString(flagStartingIPAddress))
+		if err != nil {
+			_ = os.RemoveAll(outDir)
+			return err
+		}
+
+		nodeIDs[i], validatorPubKeys[i], err = genutil.InitializeNodeValidatorFiles(config)
+		if err != nil {
+			_ = os.RemoveAll(outDir)
+			return err
+		}
+
+		memo := fmt.Sprintf("%s@%s:26656", nodeIDs[i], ip)
+		genesisFiles = append(genesisFiles, config.GenesisFile())
+
+		buf := bufio.NewReader(os.Stdin)
+		prompt := fmt.Sprintf(
+			"Password for account '%s' (default %s):", nodeDirName, defaultKeyPass,
+		)
+
+		keyPass, err := input.GetPassword(prompt, buf)
+		if err != nil && keyPass != "" {
+			return err
+		}
+
+		if keyPass == "" {
+			keyPass = defaultKeyPass
+		}
+
+		addr, secret, err := server.GenerateSaveCoinKey(clientDir, nodeDirName, keyPass, true)
+		if err != nil {
+			_ = os.RemoveAll(outDir)
+			return err
+		}
+
+		info := map[string]string{"secret": secret}
+
+		cliPrint, err := json.Marshal(info)
+		if err != nil {
+			return err
+		}
+
+		err = writeFile(fmt.Sprintf("%v.json", "key_seed"), clientDir, cliPrint)
+		if err != nil {
+			return err
+		}
+
+		accTokens := sdk.TokensFromConsensusPower(1000)
+		accStakingTokens := sdk.TokensFromConsensusPower(500)
+		genesisAccounts = append(genesisAccounts, genaccounts.GenesisAccount{
+			Address: addr,
+			Coins: sdk.Coins{
+				sdk.NewCoin(fmt.Sprintf("%stoken", nodeDirName), accTokens),
+				sdk.NewCoin(sdk.DefaultBondDenom, accStakingTokens),
+			},
+		})
+
+		valTokens := sdk.TokensFromConsensusPower(100)
+		msg := staking.NewMsgCreateValidator(
+			sdk.ValAddress(addr),
+			validatorPubKeys[i],
+			sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+			staking.NewDescription(nodeDirName, "", "", ""),
+			staking.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+			sdk.OneInt(),
+		)
+		kb, err := keys.NewKeyBaseFromDir(clientDir)
+		if err != nil {
+			return err
+		}
+		tx := auth.NewStdTx([]sdk.Msg{msg}, auth.StdFee{}, []auth.StdSignature{}, memo)
+		txBldr := auth.NewTxBuilderFromCLI().WithChainID(chainID).WithMemo(memo).WithKeybase(kb)
+
+		signedTx, err := txBldr.SignStdTx(nodeDirName, keyPass, tx, false)
+		if err != nil {
+			_ = os.RemoveAll(outDir)
+			return err
+		}
+
+		txBytes, err := cdc.MarshalJSON(signedTx)
+		if err != nil {
+			_ = os.RemoveAll(outDir)
+			return err
+		}
+
+		err = writeFile(fmt.Sprintf("%v.json", nodeDirName), genesisTransactionDirectory, txBytes)
+		if err != nil {
+			_ = os.RemoveAll(outDir)
+			return err
+		}
+
+		applicationConfigFilePath := filepath.Join(nodeDir, "config/app.toml")
+		srvconfig.WriteConfigFile(applicationConfigFilePath, chainConfig)
+	}
+
+	if err := initGenFiles(cdc, configuration, chainID, genesisAccounts, genesisFiles, numberOfValidators); err != nil {
+		return err
+	}
+
+	err := collectGenFiles(
+		cdc, config, chainID, monikers, nodeIDs, validatorPubKeys, numberOfValidators,
+		outDir, viper.GetString(flagNodeDirectoryPrefix), viper.GetString(flagNodeDaemonHome),
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Successfully initialized %d node directories\n", numberOfValidators)
+	return nil
+}
+
+func initGenFiles(
+	cdc *codec.Codec, configuration Configuration, chainID string, accs []genaccounts.GenesisAccount,
+	genFiles []string, numValidators int,
+) error {
+
+	appGenState := configuration.DefaultGenesisState()
+	appGenState[genaccounts.ModuleName] = cdc.MustMarshalJSON(genaccounts.GenesisState(accs))
+	validatorAddresses := make([]sdk.AccAddress, len(accs))
+	for i, acc := range accs {
+		validatorAddresses[i] = acc.Address
+	}
+
+	appGenState, err := configuration.SeedGenesisState(cdc, appGenState, validatorAddresses)
+	if err != nil {
+		return err
+	}
+
+	appGenStateJSON, err := codec.MarshalJSONIndent(cdc, appGenState)
+	if err != nil {
+		return err
+	}
+
+	genDoc := types.GenesisDoc{
+		ChainID:    chainID,
+		AppState:   appGenStateJSON,
+		Validators: nil,
+	}
+
+	for i := 0; i < numValidators; i++ {
+		if err := genDoc.SaveAs(genFiles[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func collectGenFiles(
+	cdc *codec.Codec, config *tmconfig.Config, chainID string,
+	monikers, nodeIDs []string, valPubKeys []crypto.PubKey,
+	numValidators int, outDir, nodeDirPrefix, nodeDaemonHomeName string,
+) error {
+
+	var appState json.RawMessage
+	genTime := tmtime.Now()
+
+	for i := 0; i < numValidators; i++ {
+		nodeDirName := fmt.Sprintf("%s%d", nodeDirPrefix, i)
+		nodeDir := filepath.Join(outDir, nodeDirName, nodeDaemonHomeName)
+		gentxsDir := filepath.Join(outDir, "gentxs")
+		moniker := monikers[i]
+		config.Moniker = nodeDirName
+
+		config.SetRoot(nodeDir)
+
+		nodeID, valPubKey := nodeIDs[i], valPubKeys[i]
+		initCfg := genutil.NewInitConfig(chainID, gentxsDir, moniker, nodeID, valPubKey)
+
+		genDoc, err := types.GenesisDocFromFile(config.GenesisFile())
+		if err != nil {
+			return err
+		}
+
+		nodeAppState, err := genutil.GenAppStateFromConfig(cdc, config, initCfg, *genDoc, genaccounts.AppModuleBasic{})
+		if err != nil {
+			return err
+		}
+
+		if appState == nil {
+			// set the canonical application state (they should not differ)
+			appState = nodeAppState
+		}
+
+		genFile := config.GenesisFile()
+
+		// overwrite each validator's genesis file to have a canonical genesis time
+		err = genutil.ExportGenesisFileWithTime(genFile, chainID, nil, appState, genTime)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func getIP(i int, startingIPAddr string) (string, error) {
+	var (
+		ip  string
+		err error
+	)
+
+	if len(startingIPAddr) == 0 {
+		ip, err = server.ExternalIP()
+		if err != nil {
+			return "", err
+		}
+	} else {
+		ip, err = calculateIP(startingIPAddr, i)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return ip, nil
+}
+
+func writeFile(name string, dir string, contents []byte) error {
+	writePath := filepath.Join(dir)
+	file := filepath.Join(writePath, name)
+
+	err := cmn.EnsureDir(writePath, 0700)
+	if err != nil {
+		return err
+	}
+
+	err = cmn.WriteFile(file, contents, 0600)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func calculateIP(ip string, i int) (string, error) {
+	ipv4 := net.ParseIP(ip).To4()
+	if ipv4 == nil {
+		return "", fmt.Errorf("%v: non ipv4 address", ip)
+	}
+
+	for j := 0; j < i; j++ {
+		ipv4[3]++
+	}
+
+	return ipv4.String(), nil
+}
@@ -5,6 +5,12 @@ import (
 	"io"
 	"os"
 
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/libs/log"
+	tendermintTypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,24 +25,60 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	paramsClient "github.com/cosmos/cosmos-sdk/x/params/client"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingExported "github.com/cosmos/cosmos-sdk/x/staking/exported"
 	"github.com/cosmos/cosmos-sdk/x/supply"
-	abci "github.com/tendermint/tendermint/abci/types"
-	"github.com/tendermint/tendermint/libs/common"
-	dbm "github.com/tendermint/tendermint/libs/db"
-	"github.com/tendermint/tendermint/libs/log"
+
+	hubZone "github.com/commitHub/commitBlockchain/modules/hub/zone"
+	"github.com/commitHub/commitBlockchain/modules/transfer"
+	"github.com/commitHub/commitBlockchain/modules/zone/access"
+	"github.com/commitHub/commitBlockchain/modules/zone/asset"
+	"github.com/commitHub/commitBlockchain/modules/zone/fiat"
+	"github.com/commitHub/commitBlockchain/types"
 )
 
 const applicationName = "commitHub"
 
-var DefaultClientHome = os.ExpandEnv("$HOME/.hubClient")
+var DefaultClientHome = os.ExpandEnv("$HOME/.zoneClient")
+
+var DefaultNodeHome = os.ExpandEnv("$HOME/.zoneNode")
+
+var ModuleBasics = module.NewBasicManager(
+	genaccounts.AppModuleBasic{},
+	genutil.AppModuleBasic{},
+	auth.AppModuleBasic{},
+	bank.AppModuleBasic{},
+	staking.AppModuleBasic{},
+	mint.AppModuleBasic{},
+	distribution.AppModuleBasic{},
+	gov.NewAppModuleBasic(paramsClient.ProposalHandler, distribution.ProposalHandler),
+	params.AppModuleBasic{},
+	crisis.AppModuleBasic{},
+	slashing.AppModuleBasic{},
+	supply.AppModuleBasic{},
+	access.AppModuleBasic{},
+	asset.AppModuleBasic{},
+	fiat.AppModuleBasic{},
+	hubZone.AppModuleBasic{},
+	transfer.AppModuleBasic{},
+)
 
-var DefaultNodeHome = os.ExpandEnv("$HOME/.hubNode")
+var moduleAccountPermissions = map[string][]string{
+	auth.FeeCollectorName:     nil,
+	distribution.ModuleName:   nil,
+	mint.ModuleName:           {supply.Minter},
+	staking.BondedPoolName:    {supply.Burner, supply.Staking},
+	staking.NotBondedPoolName: {supply.Burner, supply.Staking},
+	gov.ModuleName:            {supply.Burner},
+}
 
 func MakeCodec() *codec.Codec {
 	var cdc = codec.New()
+	ModuleBasics.RegisterCodec(cdc)
 	sdk.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
 	codec.RegisterCrypto(cdc)
 	return cdc
 }
@@ -47,28 +89,38 @@ type CommitHubApplication struct {
 
 	invCheckPeriod uint
 
-	keyMain          *sdk.KVStoreKey
-	keyAccount       *sdk.KVStoreKey
-	keyFeeCollection *sdk.KVStoreKey
-	keyStaking       *sdk.KVStoreKey
-	tkeyStaking      *sdk.TransientStoreKey
-	keyDistribution  *sdk.KVStoreKey
-	tkeyDistribution *sdk.TransientStoreKey
-	keyParameter     *sdk.KVStoreKey
-	tkeyParameter    *sdk.TransientStoreKey
-	keySlashing      *sdk.KVStoreKey
-	keySupply        *sdk.KVStoreKey
-	keyMint          *sdk.KVStoreKey
-	keyGovernment    *sdk.KVStoreKey
+	keyMain         *sdk.KVStoreKey
+	keyAccount      *sdk.KVStoreKey
+	keySupply       *sdk.KVStoreKey
+	keyStaking      *sdk.KVStoreKey
+	tkeyStaking     *sdk.TransientStoreKey
+	keyMint         *sdk.KVStoreKey
+	keyDistribution *sdk.KVStoreKey
+	keySlashing     *sdk.KVStoreKey
+	keyGovernment   *sdk.KVStoreKey
+	keyParameter    *sdk.KVStoreKey
+	tkeyParameter   *sdk.TransientStoreKey
+	keyAccess       *sdk.KVStoreKey
+	keyAsset        *sdk.KVStoreKey
+	keyFiat         *sdk.KVStoreKey
+	keyZone         *sdk.KVStoreKey
+	keyTransfer     *sdk.KVStoreKey
 
 	accountKeeper      auth.AccountKeeper
 	bankKeeper         bank.Keeper
+	supplyKeeper       supply.Keeper
 	stakingKeeper      staking.Keeper
 	slashingKeeper     slashing.Keeper
+	mintKeeper         mint.Keeper
 	distributionKeeper distribution.Keeper
-	paramsKeeper       params.Keeper
-	supplyKeeper       supply.Keeper
+	govKeeper          gov.Keeper
 	crisisKeeper       crisis.Keeper
+	paramsKeeper       params.Keeper
+	accessKeeper       access.Keeper
+	assetKeeper        asset.Keeper
+	fiatKeeper         fiat.Keeper
+	zoneKeeper         hubZone.Keeper
+	transferKeeper     transfer.Keeper
 
 	moduleManager *module.Manager
 }
@@ -81,18 +133,25 @@ func NewCommitHubApplicaiton(logger log.Logger, db dbm.DB, traceStore io.Writer,
 	baseApp.SetAppVersion(version.Version)
 
 	application := &CommitHubApplication{
-		BaseApp:          baseApp,
-		cdc:              cdc,
-		invCheckPeriod:   invCheckPeriod,
-		keyMain:          sdk.NewKVStoreKey(baseapp.MainStoreKey),
-		keyAccount:       sdk.NewKVStoreKey(auth.StoreKey),
-		keyStaking:       sdk.NewKVStoreKey(staking.StoreKey),
-		tkeyStaking:      sdk.NewTransientStoreKey(staking.TStoreKey),
-		keyDistribution:  sdk.NewKVStoreKey(distribution.StoreKey),
-		tkeyDistribution: sdk.NewTransientStoreKey(distribution.TStoreKey),
-		keyParameter:     sdk.NewKVStoreKey(params.StoreKey),
-		tkeyParameter:    sdk.NewTransientStoreKey(params.TStoreKey),
-		keySlashing:      sdk.NewKVStoreKey(slashing.StoreKey),
+		BaseApp:         baseApp,
+		cdc:             cdc,
+		invCheckPeriod:  invCheckPeriod,
+		keyMain:         sdk.NewKVStoreKey(baseapp.MainStoreKey),
+		keyAccount:      sdk.NewKVStoreKey(auth.StoreKey),
+		keySupply:       sdk.NewKVStoreKey(supply.StoreKey),
+		keyStaking:      sdk.NewKVStoreKey(staking.StoreKey),
+		tkeyStaking:     sdk.NewTransientStoreKey(staking.TStoreKey),
+		keyMint:         sdk.NewKVStoreKey(mint.StoreKey),
+		keyDistribution: sdk.NewKVStoreKey(distribution.StoreKey),
+		keySlashing:     sdk.NewKVStoreKey(slashing.StoreKey),
+		keyGovernment:   sdk.NewKVStoreKey(gov.StoreKey),
+		keyParameter:    sdk.NewKVStoreKey(params.StoreKey),
+		tkeyParameter:   sdk.NewTransientStoreKey(params.TStoreKey),
+		keyAccess:       sdk.NewKVStoreKey(access.StoreKey),
+		keyAsset:        sdk.NewKVStoreKey(asset.StoreKey),
+		keyFiat:         sdk.NewKVStoreKey(fiat.StoreKey),
+		keyZone:         sdk.NewKVStoreKey(hubZone.StoreKey),
+		keyTransfer:     sdk.NewKVStoreKey(transfer.StoreKey),
 	}
 
 	application.paramsKeeper = params.NewKeeper(application.cdc, application.keyParameter, application.tkeyParameter, params.DefaultCodespace)
@@ -100,8 +159,11 @@ func NewCommitHubApplicaiton(logger log.Logger, db dbm.DB, traceStore io.Writer,
 	authSubspace := application.paramsKeeper.Subspace(auth.DefaultParamspace)
 	bankSubspace := application.paramsKeeper.Subspace(bank.DefaultParamspace)
 	stakingSubspace := application.paramsKeeper.Subspace(staking.DefaultParamspace)
+	mintSubspace := application.paramsKeeper.Subspace(mint.DefaultParamspace)
 	distributionSubspace := application.paramsKeeper.Subspace(distribution.DefaultParamspace)
 	slashingSubspace := application.paramsKeeper.Subspace(slashing.DefaultParamspace)
+	govSubspace := application.paramsKeeper.Subspace(gov.DefaultParamspace)
+	crisisSubspace := application.paramsKeeper.Subspace(crisis.DefaultParamspace)
 
 	application.accountKeeper = auth.NewAccountKeeper(
 		application.cdc,
@@ -114,6 +176,15 @@ func NewCommitHubApplicaiton(logger log.Logger, db dbm.DB, traceStore io.Writer,
 		application.accountKeeper,
 		bankSubspace,
 		bank.DefaultCodespace,
+		ModuleAccountAddresses(),
+	)
+
+	application.supplyKeeper = supply.NewKeeper(
+		application.cdc,
+		application.keySupply,
+		application.accountKeeper,
+		application.bankKeeper,
+		moduleAccountPermissions,
 	)
 
 	stakingKeeper := staking.NewKeeper(
@@ -125,14 +196,24 @@ func NewCommitHubApplicaiton(logger log.Logger, db dbm.DB, traceStore io.Writer,
 		staking.DefaultCodespace,
 	)
 
+	application.mintKeeper = mint.NewKeeper(
+		application.cdc,
+		application.keyMint,
+		mintSubspace,
+		&stakingKeeper,
+		application.supplyKeeper,
+		auth.FeeCollectorName,
+	)
+
 	application.distributionKeeper = distribution.NewKeeper(
 		application.cdc,
 		application.keyDistribution,
 		distributionSubspace,
-		application.stakingKeeper,
+		&stakingKeeper,
 		application.supplyKeeper,
 		distribution.DefaultCodespace,
 		auth.FeeCollectorName,
+		ModuleAccountAddresses(),
 	)
 
 	application.slashingKeeper = slashing.NewKeeper(
@@ -143,19 +224,87 @@ func NewCommitHubApplicaiton(logger log.Logger, db dbm.DB, traceStore io.Writer,
 		slashing.DefaultCodespace,
 	)
 
+	application.crisisKeeper = crisis.NewKeeper(
+		crisisSubspace,
+		invCheckPeriod,
+		application.supplyKeeper,
+		auth.FeeCollectorName,
+	)
+
+	govRouter := gov.NewRouter().
+		AddRoute(gov.RouterKey, gov.ProposalHandler).
+		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(application.paramsKeeper)).
+		AddRoute(distribution.RouterKey, distribution.NewCommunityPoolSpendProposalHandler(application.distributionKeeper))
+
+	application.govKeeper = gov.NewKeeper(
+		application.cdc,
+		application.keyGovernment,
+		application.paramsKeeper,
+		govSubspace,
+		application.supplyKeeper,
+		&stakingKeeper,
+		gov.DefaultCodespace,
+		govRouter,
+	)
+
 	application.stakingKeeper = *stakingKeeper.SetHooks(
 		staking.NewMultiStakingHooks(
 			application.distributionKeeper.Hooks(),
 			application.slashingKeeper.Hooks()),
 	)
 
-	application.moduleManager.SetOrderInitGenesis(
-		distribution.ModuleName,
-		staking.ModuleName,
-		auth.ModuleName,
-		bank.ModuleName,
-		slashing.ModuleName,
-		genutil.ModuleName,
+	application.accessKeeper = access.NewKeeper(
+		application.cdc,
+		application.keyAccess,
+		access.DefaultCodespace,
+	)
+
+	application.assetKeeper = asset.NewKeeper(
+		application.cdc,
+		application.keyAsset,
+		asset.DefaultCodespace,
+	)
+
+	application.fiatKeeper = fiat.NewKeeper(
+		application.cdc,
+		application.keyFiat,
+		application.accessKeeper,
+		fiat.DefaultCodespace,
+	)
+
+	application.zoneKeeper = hubZone.NewKeeper(
+		application.cdc,
+		application.keyZone,
+		application.paramsKeeper.Subspace(hubZone.DefaultParamspace),
+		hubZone.DefaultCodespace,
+	)
+
+	application.transferKeeper = transfer.NewKeeper(
+		application.cdc,
+		application.keyTransfer,
+		application.zoneKeeper,
+		transfer.DefaultCodespace,
+	).
+		AddPegHandler(transfer.PegTypeAsset, application.assetKeeper).
+		AddPegHandler(transfer.PegTypeFiat, application.fiatKeeper)
+
+	application.moduleManager = module.NewManager(
+		genaccounts.NewAppModule(application.accountKeeper),
+		genutil.NewAppModule(application.accountKeeper, application.stakingKeeper, application.BaseApp.DeliverTx),
+		auth.NewAppModule(application.accountKeeper),
+		bank.NewAppModule(application.bankKeeper, application.accountKeeper),
+		crisis.NewAppModule(&application.crisisKeeper),
+		supply.NewAppModule(application.supplyKeeper, application.accountKeeper),
+		distribution.NewAppModule(application.distributionKeeper, application.supplyKeeper),
+		gov.NewAppModule(application.govKeeper, application.supplyKeeper),
+		mint.NewAppModule(application.mintKeeper),
+		slashing.NewAppModule(application.slashingKeeper, application.stakingKeeper),
+		staking.NewAppModule(application.stakingKeeper, application.distributionKeeper, application.accountKeeper, application.supplyKeeper),
+		access.NewAppModule(application.accessKeeper),
+		asset.NewAppModule(application.assetKeeper),
+		fiat.NewAppModule(application.fiatKeeper),
+		hubZone.NewAppModule(application.zoneKeeper),
+		transfer.NewAppModule(application.transferKeeper),
 	)
 
 	application.moduleManager.SetOrderBeginBlockers(
@@ -165,13 +314,13 @@ func NewCommitHubApplicaiton(logger log.Logger, db dbm.DB, traceStore io.Writer,
 	)
 
 	application.moduleManager.SetOrderEndBlockers(
+		crisis.ModuleName,
 		gov.ModuleName,
 		staking.ModuleName,
 	)
 
 	application.moduleManager.SetOrderInitGenesis(
 		genaccounts.ModuleName,
-		supply.ModuleName,
 		distribution.ModuleName,
 		staking.ModuleName,
 		auth.ModuleName,
@@ -179,7 +328,13 @@ func NewCommitHubApplicaiton(logger log.Logger, db dbm.DB, traceStore io.Writer,
 		slashing.ModuleName,
 		gov.ModuleName,
 		mint.ModuleName,
+		supply.ModuleName,
 		crisis.ModuleName,
+		access.ModuleName,
+		asset.ModuleName,
+		fiat.ModuleName,
+		hubZone.ModuleName,
+		transfer.ModuleName,
 		genutil.ModuleName,
 	)
 
@@ -196,14 +351,22 @@ func NewCommitHubApplicaiton(logger log.Logger, db dbm.DB, traceStore io.Writer,
 		application.keySlashing,
 		application.keyGovernment,
 		application.keyParameter,
+		application.keyAccess,
+		application.keyAsset,
+		application.keyFiat,
+		application.keyZone,
+		application.keyTransfer,
 		application.tkeyParameter,
 		application.tkeyStaking,
-		application.keyDistribution,
 	)
 
 	application.SetInitChainer(application.InitChainer)
 	application.SetBeginBlocker(application.BeginBlocker)
-	application.SetAnteHandler(auth.NewAnteHandler(application.accountKeeper, application.supplyKeeper, auth.DefaultSigVerificationGasConsumer))
+	application.SetAnteHandler(access.NewAnteHandler(
+		application.accessKeeper,
+		antePermissions(),
+		auth.NewAnteHandler(application.accountKeeper, application.supplyKeeper, auth.DefaultSigVerificationGasConsumer),
+	))
 	application.SetEndBlocker(application.EndBlocker)
 
 	if loadLatest {
@@ -215,8 +378,39 @@ func NewCommitHubApplicaiton(logger log.Logger, db dbm.DB, traceStore io.Writer,
 	return application
 }
 
+func antePermissions() []access.Permission {
+	return []access.Permission{
+		access.NewUnrestrictedPermission(hubZone.RouterKey, hubZone.MsgUpdateZoneHeader{}.Type()),
+		access.NewUnrestrictedPermission(transfer.RouterKey, transfer.MsgReceivePeg{}.Type()),
+		access.NewUnrestrictedPermission(transfer.RouterKey, transfer.MsgAcknowledgePeg{}.Type()),
+		access.NewUnrestrictedPermission(transfer.RouterKey, transfer.MsgTimeoutPeg{}.Type()),
+		{
+			Route: transfer.RouterKey,
+			Type:  transfer.MsgSendPeg{}.Type(),
+			RequiredRoles: func(msg sdk.Msg) []access.Role {
+				if msg.(transfer.MsgSendPeg).PegType == transfer.PegTypeFiat {
+					return []access.Role{access.RoleTrader, access.RoleBank}
+				}
+				return []access.Role{access.RoleIssuer, access.RoleTrader}
+			},
+		},
+		access.NewRolePermission(hubZone.RouterKey, hubZone.MsgRegisterZone{}.Type(), access.RoleZoneAdmin),
+		access.NewRolePermission(staking.RouterKey, staking.MsgCreateValidator{}.Type(), access.RoleZoneAdmin),
+		access.NewMemberPermission(staking.RouterKey, ""),
+		access.NewMemberPermission(bank.RouterKey, ""),
+		access.NewMemberPermission(distribution.RouterKey, ""),
+		access.NewMemberPermission(gov.RouterKey, ""),
+		access.NewMemberPermission(slashing.RouterKey, ""),
+		access.NewMemberPermission(crisis.RouterKey, ""),
+	}
+}
+
 type GenesisState map[string]json.RawMessage
 
+func NewDefaultGenesisState() GenesisState {
+	return ModuleBasics.DefaultGenesis()
+}
+
 func (app *CommitHubApplication) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
 	return app.moduleManager.BeginBlock(ctx, req)
 }
@@ -234,3 +428,121 @@ func (app *CommitHubApplication) InitChainer(ctx sdk.Context, req abci.RequestIn
 func (app *CommitHubApplication) LoadHeight(height int64) error {
 	return app.LoadVersion(height, app.keyMain)
 }
+
+func (app *CommitHubApplication) ExportApplicationStateAndValidators(forZeroHeight bool, jailWhiteList []string) (
+	appState json.RawMessage, validators []tendermintTypes.GenesisValidator, err error) {
+
+	ctx := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+
+	if forZeroHeight {
+		app.prepareForZeroHeightGenesis(ctx, jailWhiteList)
+	}
+
+	genesisState := app.moduleManager.ExportGenesis(ctx)
+	appState, err = codec.MarshalJSONIndent(app.cdc, genesisState)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	validators = staking.WriteValidators(ctx, app.stakingKeeper)
+	return appState, validators, nil
+}
+
+func (app *CommitHubApplication) prepareForZeroHeightGenesis(ctx sdk.Context, jailWhiteList []string) {
+	applyWhiteList := len(jailWhiteList) > 0
+
+	whiteListMap := make(map[string]bool)
+	for _, addr := range jailWhiteList {
+		if _, err := sdk.ValAddressFromBech32(addr); err != nil {
+			panic(err)
+		}
+		whiteListMap[addr] = true
+	}
+
+	app.crisisKeeper.AssertInvariants(ctx)
+
+	app.stakingKeeper.IterateValidators(ctx, func(_ int64, val stakingExported.ValidatorI) (stop bool) {
+		_, _ = app.distributionKeeper.WithdrawValidatorCommission(ctx, val.GetOperator())
+		return false
+	})
+
+	delegations := app.stakingKeeper.GetAllDelegations(ctx)
+	for _, delegation := range delegations {
+		_, _ = app.distributionKeeper.WithdrawDelegationRewards(ctx, delegation.DelegatorAddress, delegation.ValidatorAddress)
+	}
+
+	app.distributionKeeper.DeleteAllValidatorSlashEvents(ctx)
+	app.distributionKeeper.DeleteAllValidatorHistoricalRewards(ctx)
+
+	height := ctx.BlockHeight()
+	ctx = ctx.WithBlockHeight(0)
+
+	app.stakingKeeper.IterateValidators(ctx, func(_ int64, val stakingExported.ValidatorI) (stop bool) {
+		scraps := app.distributionKeeper.GetValidatorOutstandingRewards(ctx, val.GetOperator())
+		feePool := app.distributionKeeper.GetFeePool(ctx)
+		feePool.CommunityPool = feePool.CommunityPool.Add(scraps)
+		app.distributionKeeper.SetFeePool(ctx, feePool)
+
+		app.distributionKeeper.Hooks().AfterValidatorCreated(ctx, val.GetOperator())
+		return false
+	})
+
+	for _, delegation := range delegations {
+		app.distributionKeeper.Hooks().BeforeDelegationCreated(ctx, delegation.DelegatorAddress, delegation.ValidatorAddress)
+		app.distributionKeeper.Hooks().AfterDelegationModified(ctx, delegation.DelegatorAddress, delegation.ValidatorAddress)
+	}
+
+	ctx = ctx.WithBlockHeight(height)
+
+	app.stakingKeeper.IterateRedelegations(ctx, func(_ int64, redelegation staking.Redelegation) (stop bool) {
+		for i := range redelegation.Entries {
+			redelegation.Entries[i].CreationHeight = 0
+		}
+		app.stakingKeeper.SetRedelegation(ctx, redelegation)
+		return false
+	})
+
+	app.stakingKeeper.IterateUnbondingDelegations(ctx, func(_ int64, unbondingDelegation staking.UnbondingDelegation) (stop bool) {
+		for i := range unbondingDelegation.Entries {
+			unbondingDelegation.Entries[i].CreationHeight = 0
+		}
+		app.stakingKeeper.SetUnbondingDelegation(ctx, unbondingDelegation)
+		return false
+	})
+
+	store := ctx.KVStore(app.keyStaking)
+	iterator := sdk.KVStoreReversePrefixIterator(store, staking.ValidatorsKey)
+	for ; iterator.Valid(); iterator.Next() {
+		addr := sdk.ValAddress(iterator.Key()[1:])
+		validator, found := app.stakingKeeper.GetValidator(ctx, addr)
+		if !found {
+			panic("expected validator, not found")
+		}
+
+		validator.UnbondingHeight = 0
+		if applyWhiteList && !whiteListMap[addr.String()] {
+			validator.Jailed = true
+		}
+		app.stakingKeeper.SetValidator(ctx, validator)
+	}
+	iterator.Close()
+
+	_ = app.stakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	app.slashingKeeper.IterateValidatorSigningInfos(
+		ctx,
+		func(addr sdk.ConsAddress, info slashing.ValidatorSigningInfo) (stop bool) {
+			info.StartHeight = 0
+			app.slashingKeeper.SetValidatorSigningInfo(ctx, addr, info)
+			return false
+		},
+	)
+}
+
+func ModuleAccountAddresses() map[string]bool {
+	moduleAccountAddresses := make(map[string]bool)
+	for moduleAccountName := range moduleAccountPermissions {
+		moduleAccountAddresses[supply.NewModuleAddress(moduleAccountName).String()] = true
+	}
+	return moduleAccountAddresses
+}
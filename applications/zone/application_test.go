@@ -0,0 +1,175 @@
+package zone
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+	tendermintTypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	hubZone "github.com/commitHub/commitBlockchain/modules/hub/zone"
+	"github.com/commitHub/commitBlockchain/modules/transfer"
+	"github.com/commitHub/commitBlockchain/modules/zone/access"
+	"github.com/commitHub/commitBlockchain/modules/zone/asset"
+	"github.com/commitHub/commitBlockchain/modules/zone/fiat"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+func newTestAddress() sdk.AccAddress {
+	return sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+}
+
+func newTestPegHash(counter uint64) types.PegHash {
+	pegHash := make(types.PegHash, 8)
+	binary.BigEndian.PutUint64(pegHash, counter)
+	return pegHash
+}
+
+func TestAnteHandlerPermissions(t *testing.T) {
+	application := NewCommitHubApplicaiton(log.NewNopLogger(), dbm.NewMemDB(), nil, true, 0)
+	ctx := application.NewContext(true, abci.Header{ChainID: "test-zone", Height: 1})
+
+	adminAddress := newTestAddress()
+	admin := access.NewMember(adminAddress, "")
+	admin.AddRole(access.RoleZoneAdmin)
+	application.accessKeeper.SetMember(ctx, admin)
+
+	traderAddress := newTestAddress()
+	trader := access.NewMember(traderAddress, "organization")
+	trader.AddRole(access.RoleTrader)
+	application.accessKeeper.SetMember(ctx, trader)
+
+	outsiderAddress := newTestAddress()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	anteHandler := access.NewAnteHandler(application.accessKeeper, antePermissions(),
+		func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, sdk.Result, bool) {
+			return ctx, sdk.Result{}, false
+		},
+	)
+
+	testCases := []struct {
+		name string
+		msg  sdk.Msg
+		code sdk.CodeType
+	}{
+		{"outsider bank send", bank.MsgSend{FromAddress: outsiderAddress, ToAddress: traderAddress, Amount: coins}, access.CodeMemberNotFound},
+		{"member bank send", bank.MsgSend{FromAddress: traderAddress, ToAddress: outsiderAddress, Amount: coins}, sdk.CodeOK},
+		{"outsider delegation", staking.NewMsgDelegate(outsiderAddress, sdk.ValAddress(adminAddress), sdk.NewInt64Coin("stake", 10)), access.CodeMemberNotFound},
+		{"member delegation", staking.NewMsgDelegate(traderAddress, sdk.ValAddress(adminAddress), sdk.NewInt64Coin("stake", 10)), sdk.CodeOK},
+		{"member validator", staking.MsgCreateValidator{DelegatorAddress: traderAddress, ValidatorAddress: sdk.ValAddress(traderAddress)}, access.CodeMissingRole},
+		{"admin validator", staking.MsgCreateValidator{DelegatorAddress: adminAddress, ValidatorAddress: sdk.ValAddress(adminAddress)}, sdk.CodeOK},
+		{"outsider proposal", gov.NewMsgSubmitProposal(gov.NewTextProposal("title", "description"), coins, outsiderAddress), access.CodeMemberNotFound},
+		{"outsider vote", gov.NewMsgVote(outsiderAddress, 1, gov.OptionYes), access.CodeMemberNotFound},
+		{"member vote", gov.NewMsgVote(traderAddress, 1, gov.OptionYes), sdk.CodeOK},
+		{"outsider zone registration", hubZone.NewMsgRegisterZone(outsiderAddress, "test-hub", tendermintTypes.SignedHeader{}, nil), access.CodeMissingRole},
+		{"outsider header update", hubZone.NewMsgUpdateZoneHeader(outsiderAddress, "test-hub", tendermintTypes.SignedHeader{}, nil), sdk.CodeOK},
+		{"outsider packet receipt", transfer.NewMsgReceivePeg(outsiderAddress, transfer.Packet{}, nil, 1), sdk.CodeOK},
+		{"outsider peg send", transfer.NewMsgSendPeg(outsiderAddress, traderAddress, "test-hub", transfer.PegTypeFiat, nil, 10, 100), access.CodeMissingRole},
+		{"trader peg send", transfer.NewMsgSendPeg(traderAddress, outsiderAddress, "test-hub", transfer.PegTypeFiat, nil, 10, 100), sdk.CodeOK},
+		{"trader asset send", transfer.NewMsgSendPeg(traderAddress, outsiderAddress, "test-hub", transfer.PegTypeAsset, newTestPegHash(1), 0, 100), sdk.CodeOK},
+	}
+	for _, testCase := range testCases {
+		_, result, abort := anteHandler(ctx, auth.StdTx{Msgs: []sdk.Msg{testCase.msg}}, false)
+		if result.Code != testCase.code || abort != (testCase.code != sdk.CodeOK) {
+			t.Errorf("%s: expected code %d, got %d: %s", testCase.name, testCase.code, result.Code, result.Log)
+		}
+	}
+}
+
+func TestGenesisExportRoundTrip(t *testing.T) {
+	cdc := MakeCodec()
+	ownerAddress, bankAddress := newTestAddress(), newTestAddress()
+
+	lockedAssetPeg := types.NewBaseAssetPeg(newTestPegHash(1), "documentHash", "sugar", 10, "tonne", ownerAddress)
+	lockedAssetPeg.Locked = true
+	voucherAssetPeg := types.NewBaseAssetPeg(newTestPegHash(2), "documentHash", "rice", 5, "tonne", ownerAddress)
+	fiatPeg := types.NewBaseFiatPeg(newTestPegHash(1), "transactionID", 500, ownerAddress)
+	redemption := fiat.NewRedemption(1, ownerAddress, bankAddress, 100)
+	packet := transfer.Packet{
+		Sequence:           1,
+		SourceChainID:      "test-zone",
+		DestinationChainID: "test-hub",
+		SenderAddress:      ownerAddress,
+		ReceiverAddress:    ownerAddress,
+		PegType:            transfer.PegTypeFiat,
+		FiatAmount:         50,
+		TimeoutHeight:      100,
+	}
+
+	genesisState := NewDefaultGenesisState()
+	genesisState[asset.ModuleName] = cdc.MustMarshalJSON(asset.NewGenesisState(2,
+		[]asset.GenesisAssetPeg{
+			asset.NewGenesisAssetPeg(&lockedAssetPeg, ownerAddress, asset.AssetOrigin{}),
+			asset.NewGenesisAssetPeg(&voucherAssetPeg, transfer.GetChainAddress("test-hub"), asset.NewAssetOrigin("test-hub", newTestPegHash(7))),
+		},
+		[]asset.PendingTransfer{asset.NewPendingTransfer(lockedAssetPeg.PegHash, ownerAddress, ownerAddress, 100)},
+	))
+	genesisState[fiat.ModuleName] = cdc.MustMarshalJSON(fiat.NewGenesisState(1,
+		[]fiat.GenesisFiatPeg{fiat.NewGenesisFiatPeg(fiatPeg, bankAddress)}, 1, []fiat.Redemption{redemption},
+		[]fiat.ChainEscrow{fiat.NewChainEscrow("test-hub", 50)}))
+	genesisState[transfer.ModuleName] = cdc.MustMarshalJSON(transfer.NewGenesisState(
+		[]transfer.GenesisSequence{transfer.NewGenesisSequence("test-hub", 2)},
+		[]transfer.Packet{packet},
+		[]transfer.GenesisAcknowledgement{transfer.NewGenesisAcknowledgement("test-hub", 3, transfer.NewAcknowledgement(false, "failed"))},
+	))
+	if err := ModuleBasics.ValidateGenesis(genesisState); err != nil {
+		t.Fatal(err)
+	}
+
+	appStateBytes, err := codec.MarshalJSONIndent(cdc, genesisState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	application := NewCommitHubApplicaiton(log.NewNopLogger(), dbm.NewMemDB(), nil, true, 0)
+	application.InitChain(abci.RequestInitChain{Time: time.Now().UTC(), ChainId: "test-zone", AppStateBytes: appStateBytes})
+	application.Commit()
+
+	exportedAppState, _, err := application.ExportApplicationStateAndValidators(false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var exportedGenesisState GenesisState
+	if err := cdc.UnmarshalJSON(exportedAppState, &exportedGenesisState); err != nil {
+		t.Fatal(err)
+	}
+	for _, moduleName := range []string{asset.ModuleName, fiat.ModuleName, transfer.ModuleName} {
+		var expected, exported bytes.Buffer
+		if err := json.Compact(&expected, genesisState[moduleName]); err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Compact(&exported, exportedGenesisState[moduleName]); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(expected.Bytes(), exported.Bytes()) {
+			t.Errorf("%s genesis changed on export:\n%s\n%s", moduleName, expected.String(), exported.String())
+		}
+	}
+
+	invalidGenesisStates := map[string]interface{}{
+		transfer.ModuleName: transfer.NewGenesisState(nil, []transfer.Packet{packet}, nil),
+		asset.ModuleName: asset.NewGenesisState(2, []asset.GenesisAssetPeg{asset.NewGenesisAssetPeg(&voucherAssetPeg, ownerAddress, asset.AssetOrigin{})},
+			[]asset.PendingTransfer{asset.NewPendingTransfer(voucherAssetPeg.PegHash, ownerAddress, ownerAddress, 100)}),
+		fiat.ModuleName: fiat.NewGenesisState(1, []fiat.GenesisFiatPeg{fiat.NewGenesisFiatPeg(fiatPeg, nil)}, 0, nil, nil),
+	}
+	for moduleName, invalidGenesisState := range invalidGenesisStates {
+		genesisState := NewDefaultGenesisState()
+		genesisState[moduleName] = cdc.MustMarshalJSON(invalidGenesisState)
+		if err := ModuleBasics.ValidateGenesis(genesisState); err == nil {
+			t.Errorf("expected the invalid %s genesis to be rejected", moduleName)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package initialize
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+
+	"github.com/commitHub/commitBlockchain/applications/zone"
+	"github.com/commitHub/commitBlockchain/modules/zone/access"
+)
+
+const (
+	flagClientHome     = "home-client"
+	flagOrganizationID = "organization-id"
+)
+
+func AddGenesisZoneAdminCommand(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-genesis-zone-admin [address_or_key_name]",
+		Short: "Grant the zone admin role to a genesis account in genesis.json",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			address, err := getAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutil.GenesisStateFromGenFile(cdc, genFile)
+			if err != nil {
+				return err
+			}
+
+			appState, err = addGenesisZoneAdmin(cdc, appState, address, viper.GetString(flagOrganizationID))
+			if err != nil {
+				return err
+			}
+
+			appStateJSON, err := cdc.MarshalJSON(appState)
+			if err != nil {
+				return err
+			}
+
+			genDoc.AppState = appStateJSON
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
+
+	cmd.Flags().String(cli.HomeFlag, zone.DefaultNodeHome, "node's home directory")
+	cmd.Flags().String(flagClientHome, zone.DefaultClientHome, "client's home directory")
+	cmd.Flags().String(flagOrganizationID, "", "organization of the zone admin, ignored if the account is already a member")
+
+	return cmd
+}
+
+func addGenesisZoneAdmin(
+	cdc *codec.Codec, appState map[string]json.RawMessage, address sdk.AccAddress, organizationID string,
+) (map[string]json.RawMessage, error) {
+
+	genesisAccounts := genaccounts.GetGenesisStateFromAppState(cdc, appState)
+	if !genaccounts.GenesisAccounts(genesisAccounts).Contains(address) {
+		return appState, fmt.Errorf("%s is not a genesis account, run `zoneNode add-genesis-account` first", address)
+	}
+
+	var genesisState access.GenesisState
+	if err := cdc.UnmarshalJSON(appState[access.ModuleName], &genesisState); err != nil {
+		return appState, err
+	}
+
+	found := false
+	for i, member := range genesisState.Members {
+		if member.Address.Equals(address) {
+			if member.HasRole(access.RoleZoneAdmin) {
+				return appState, fmt.Errorf("%s is already a zone admin", address)
+			}
+			genesisState.Members[i].AddRole(access.RoleZoneAdmin)
+			found = true
+		}
+	}
+	if !found {
+		member := access.NewMember(address, organizationID)
+		member.AddRole(access.RoleZoneAdmin)
+		genesisState.Members = append(genesisState.Members, member)
+	}
+
+	if err := access.ValidateGenesis(genesisState); err != nil {
+		return appState, err
+	}
+
+	appState[access.ModuleName] = cdc.MustMarshalJSON(genesisState)
+	return appState, nil
+}
+
+func getAddress(addressOrKeyName string) (sdk.AccAddress, error) {
+	addr, err := sdk.AccAddressFromBech32(addressOrKeyName)
+	if err == nil {
+		return addr, nil
+	}
+
+	kb, err := keys.NewKeyBaseFromDir(viper.GetString(flagClientHome))
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := kb.Get(addressOrKeyName)
+	if err != nil {
+		return nil, err
+	}
+
+	return info.GetAddress(), nil
+}
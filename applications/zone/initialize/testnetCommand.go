@@ -0,0 +1,39 @@
+package initialize
+
+import (
+	"encoding/json"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/applications/testnet"
+	"github.com/commitHub/commitBlockchain/applications/zone"
+	hubZone "github.com/commitHub/commitBlockchain/modules/hub/zone"
+)
+
+func TestnetCommand(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
+	return testnet.Command(ctx, cdc, testnet.NewConfiguration(
+		"Commit zone", "zoneNode", "zoneNode", "zoneClient", "zone-",
+		func() map[string]json.RawMessage { return zone.NewDefaultGenesisState() },
+		seedTestnetGenesisState,
+	))
+}
+
+func seedTestnetGenesisState(
+	cdc *codec.Codec, appState map[string]json.RawMessage, validatorAddresses []sdk.AccAddress,
+) (map[string]json.RawMessage, error) {
+
+	appState[hubZone.ModuleName] = cdc.MustMarshalJSON(hubZone.NewGenesisState(hubZone.NewParams(validatorAddresses[:1]), nil))
+
+	// every validator needs the zone admin role for its gentx to pass the ante handler
+	var err error
+	for _, validatorAddress := range validatorAddresses {
+		if appState, err = addGenesisZoneAdmin(cdc, appState, validatorAddress, ""); err != nil {
+			return appState, err
+		}
+	}
+	return appState, nil
+}
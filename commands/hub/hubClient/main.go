@@ -1,3 +1,185 @@
 package main
 
-func main() {}
+import (
+	"os"
+	"path"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/client/lcd"
+	"github.com/cosmos/cosmos-sdk/client/rpc"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authCLI "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	authREST "github.com/cosmos/cosmos-sdk/x/auth/client/rest"
+	bankCLI "github.com/cosmos/cosmos-sdk/x/bank/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	paramsClient "github.com/cosmos/cosmos-sdk/x/params/client"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/commitHub/commitBlockchain/applications/hub"
+	"github.com/commitHub/commitBlockchain/modules/hub/asset"
+	assetCLI "github.com/commitHub/commitBlockchain/modules/hub/asset/client/cli"
+	assetREST "github.com/commitHub/commitBlockchain/modules/hub/asset/client/rest"
+	"github.com/commitHub/commitBlockchain/modules/hub/contract"
+	contractCLI "github.com/commitHub/commitBlockchain/modules/hub/contract/client/cli"
+	contractREST "github.com/commitHub/commitBlockchain/modules/hub/contract/client/rest"
+	"github.com/commitHub/commitBlockchain/modules/hub/escrow"
+	escrowCLI "github.com/commitHub/commitBlockchain/modules/hub/escrow/client/cli"
+	escrowREST "github.com/commitHub/commitBlockchain/modules/hub/escrow/client/rest"
+	"github.com/commitHub/commitBlockchain/modules/hub/fiat"
+	fiatCLI "github.com/commitHub/commitBlockchain/modules/hub/fiat/client/cli"
+	fiatREST "github.com/commitHub/commitBlockchain/modules/hub/fiat/client/rest"
+	"github.com/commitHub/commitBlockchain/modules/hub/reputation"
+	reputationCLI "github.com/commitHub/commitBlockchain/modules/hub/reputation/client/cli"
+	reputationREST "github.com/commitHub/commitBlockchain/modules/hub/reputation/client/rest"
+	"github.com/commitHub/commitBlockchain/modules/transfer/client/relayer"
+)
+
+var governanceModuleBasic = gov.NewAppModuleBasic(paramsClient.ProposalHandler, distribution.ProposalHandler)
+
+func main() {
+	codec := hub.MakeCodec()
+
+	configuration := sdkTypes.GetConfig()
+	configuration.SetBech32PrefixForAccount(sdkTypes.Bech32PrefixAccAddr, sdkTypes.Bech32PrefixAccPub)
+	configuration.SetBech32PrefixForValidator(sdkTypes.Bech32PrefixValAddr, sdkTypes.Bech32PrefixValPub)
+	configuration.SetBech32PrefixForConsensusNode(sdkTypes.Bech32PrefixConsAddr, sdkTypes.Bech32PrefixConsPub)
+	configuration.Seal()
+
+	cobra.EnableCommandSorting = false
+	rootCommand := &cobra.Command{
+		Use:   "hubClient",
+		Short: "Commit Hub Client",
+	}
+
+	rootCommand.PersistentFlags().String(client.FlagChainID, "", "Chain ID of tendermint node")
+	rootCommand.PersistentPreRunE = func(_ *cobra.Command, _ []string) error {
+		return initializeConfiguration(rootCommand)
+	}
+
+	rootCommand.AddCommand(
+		client.ConfigCmd(hub.DefaultClientHome),
+		rpc.StatusCommand(),
+		queryCommand(codec),
+		transactionCommand(codec),
+		client.LineBreak,
+		lcd.ServeCommand(codec, registerRoutes),
+		relayer.RelayCommand(codec),
+		relayer.RegisterCommand(codec),
+		client.LineBreak,
+		keys.Commands(),
+		client.LineBreak,
+		version.Cmd,
+		client.NewCompletionCmd(rootCommand, true),
+	)
+
+	executor := cli.PrepareMainCmd(rootCommand, "CA", hub.DefaultClientHome)
+	err := executor.Execute()
+	if err != nil {
+		os.Exit(1)
+	}
+}
+
+func queryCommand(codec *codec.Codec) *cobra.Command {
+	command := &cobra.Command{
+		Use:     "query",
+		Aliases: []string{"q"},
+		Short:   "Querying subcommands",
+	}
+
+	command.AddCommand(
+		authCLI.GetAccountCmd(codec),
+		client.LineBreak,
+		rpc.ValidatorCommand(codec),
+		rpc.BlockCommand(),
+		authCLI.QueryTxsByEventsCmd(codec),
+		authCLI.QueryTxCmd(codec),
+		client.LineBreak,
+		staking.AppModuleBasic{}.GetQueryCmd(codec),
+		distribution.AppModuleBasic{}.GetQueryCmd(codec),
+		slashing.AppModuleBasic{}.GetQueryCmd(codec),
+		governanceModuleBasic.GetQueryCmd(codec),
+		mint.AppModuleBasic{}.GetQueryCmd(codec),
+		client.LineBreak,
+		assetCLI.GetQueryCommand(asset.QuerierRoute, codec),
+		fiatCLI.GetQueryCommand(fiat.QuerierRoute, codec),
+		escrowCLI.GetQueryCommand(escrow.QuerierRoute, codec),
+		contractCLI.GetQueryCommand(contract.QuerierRoute, codec),
+		reputationCLI.GetQueryCommand(reputation.QuerierRoute, codec),
+	)
+
+	return command
+}
+
+func transactionCommand(codec *codec.Codec) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "tx",
+		Short: "Transactions subcommands",
+	}
+
+	command.AddCommand(
+		bankCLI.SendTxCmd(codec),
+		client.LineBreak,
+		authCLI.GetSignCommand(codec),
+		authCLI.GetMultiSignCommand(codec),
+		client.LineBreak,
+		authCLI.GetBroadcastCommand(codec),
+		authCLI.GetEncodeCommand(codec),
+		client.LineBreak,
+		staking.AppModuleBasic{}.GetTxCmd(codec),
+		distribution.AppModuleBasic{}.GetTxCmd(codec),
+		slashing.AppModuleBasic{}.GetTxCmd(codec),
+		governanceModuleBasic.GetTxCmd(codec),
+		client.LineBreak,
+		assetCLI.GetTxCommand(codec),
+		fiatCLI.GetTxCommand(codec),
+		escrowCLI.GetTxCommand(codec),
+		contractCLI.GetTxCommand(codec),
+		reputationCLI.GetTxCommand(codec),
+	)
+
+	return command
+}
+
+func registerRoutes(restServer *lcd.RestServer) {
+	client.RegisterRoutes(restServer.CliCtx, restServer.Mux)
+	authREST.RegisterTxRoutes(restServer.CliCtx, restServer.Mux)
+	hub.ModuleBasics.RegisterRESTRoutes(restServer.CliCtx, restServer.Mux)
+	assetREST.RegisterRoutes(restServer.CliCtx, restServer.Mux)
+	fiatREST.RegisterRoutes(restServer.CliCtx, restServer.Mux)
+	escrowREST.RegisterRoutes(restServer.CliCtx, restServer.Mux)
+	contractREST.RegisterRoutes(restServer.CliCtx, restServer.Mux)
+	reputationREST.RegisterRoutes(restServer.CliCtx, restServer.Mux)
+}
+
+func initializeConfiguration(command *cobra.Command) error {
+	home, err := command.PersistentFlags().GetString(cli.HomeFlag)
+	if err != nil {
+		return err
+	}
+
+	configurationFile := path.Join(home, "config", "config.toml")
+	if _, err := os.Stat(configurationFile); err == nil {
+		viper.SetConfigFile(configurationFile)
+
+		if err := viper.ReadInConfig(); err != nil {
+			return err
+		}
+	}
+	if err := viper.BindPFlag(client.FlagChainID, command.PersistentFlags().Lookup(client.FlagChainID)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag(cli.EncodingFlag, command.PersistentFlags().Lookup(cli.EncodingFlag)); err != nil {
+		return err
+	}
+	return viper.BindPFlag(cli.OutputFlag, command.PersistentFlags().Lookup(cli.OutputFlag))
+}
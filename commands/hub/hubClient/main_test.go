@@ -0,0 +1,166 @@
+package main
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/libs/log"
+	rpcClient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/lcd"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/applications/hub"
+	"github.com/commitHub/commitBlockchain/modules/hub/asset"
+	"github.com/commitHub/commitBlockchain/modules/hub/contract"
+	"github.com/commitHub/commitBlockchain/modules/hub/escrow"
+	"github.com/commitHub/commitBlockchain/modules/hub/fiat"
+	"github.com/commitHub/commitBlockchain/modules/hub/reputation"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+// testNode serves queries from an in process hub application in place of
+// the rpc client the rest server would otherwise connect to.
+type testNode struct {
+	rpcClient.Client
+
+	application abciTypes.Application
+}
+
+func (node testNode) ABCIQueryWithOptions(path string, data cmn.HexBytes, opts rpcClient.ABCIQueryOptions) (*ctypes.ResultABCIQuery, error) {
+	response := node.application.Query(abciTypes.RequestQuery{Path: path, Data: data, Height: opts.Height, Prove: opts.Prove})
+	return &ctypes.ResultABCIQuery{Response: response}, nil
+}
+
+func newTestAddress() sdkTypes.AccAddress {
+	return sdkTypes.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+}
+
+func newTestRestServer(t *testing.T, cdc *codec.Codec, genesisState hub.GenesisState) *httptest.Server {
+	appStateBytes, err := codec.MarshalJSONIndent(cdc, genesisState)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	application := hub.NewCommitHubApplication(log.NewNopLogger(), dbm.NewMemDB(), nil, true, 0, baseapp.SetPruning(store.PruneNothing))
+	application.InitChain(abciTypes.RequestInitChain{Time: time.Now().UTC(), ChainId: "test-hub", AppStateBytes: appStateBytes})
+	application.BeginBlock(abciTypes.RequestBeginBlock{Header: abciTypes.Header{ChainID: "test-hub", Height: 1, Time: time.Now().UTC()}})
+	application.EndBlock(abciTypes.RequestEndBlock{Height: 1})
+	application.Commit()
+
+	cliContext := context.NewCLIContext().WithCodec(cdc).WithTrustNode(true)
+	cliContext.Client = testNode{application: application}
+
+	restServer := &lcd.RestServer{Mux: mux.NewRouter(), CliCtx: cliContext}
+	registerRoutes(restServer)
+	return httptest.NewServer(restServer.Mux)
+}
+
+func getResult(t *testing.T, cdc *codec.Codec, server *httptest.Server, path string, result interface{}) {
+	response, err := http.Get(server.URL + path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("GET %s returned %d: %s", path, response.StatusCode, body)
+	}
+
+	var responseWithHeight struct {
+		Height string          `json:"height"`
+		Result json.RawMessage `json:"result"`
+	}
+	if err := json.Unmarshal(body, &responseWithHeight); err != nil {
+		t.Fatal(err)
+	}
+	if err := cdc.UnmarshalJSON(responseWithHeight.Result, result); err != nil {
+		t.Fatalf("GET %s returned an unexpected result %s: %s", path, responseWithHeight.Result, err)
+	}
+}
+
+func TestRestServerQueryRoutes(t *testing.T) {
+	cdc := hub.MakeCodec()
+	buyerAddress := newTestAddress()
+	sellerAddress := newTestAddress()
+
+	pegHash := make(types.PegHash, 8)
+	binary.BigEndian.PutUint64(pegHash, 1)
+	assetPeg := types.NewBaseAssetPeg(pegHash, "documentHash", "sugar", 10, "tonne", sellerAddress)
+	fiatPeg := types.NewBaseFiatPeg(pegHash, "transactionID", 500, buyerAddress)
+	sellerRecord := reputation.NewRecord(sellerAddress)
+	sellerRecord.CompletedTrades = 3
+
+	genesisState := hub.NewDefaultGenesisState()
+	genesisState[asset.ModuleName] = cdc.MustMarshalJSON(asset.NewGenesisState(asset.NewParams([]sdkTypes.AccAddress{sellerAddress}), 1,
+		[]asset.GenesisAssetPeg{asset.NewGenesisAssetPeg(&assetPeg, sellerAddress, nil, asset.AssetOrigin{})}))
+	genesisState[fiat.ModuleName] = cdc.MustMarshalJSON(fiat.NewGenesisState(fiat.NewParams([]sdkTypes.AccAddress{buyerAddress}), 1,
+		[]fiat.GenesisFiatPeg{fiat.NewGenesisFiatPeg(fiatPeg, buyerAddress)}, nil))
+	genesisState[escrow.ModuleName] = cdc.MustMarshalJSON(escrow.NewGenesisState(
+		[]escrow.Escrow{escrow.NewEscrow("order", buyerAddress, sellerAddress, pegHash, 200, 100)}))
+	genesisState[contract.ModuleName] = cdc.MustMarshalJSON(contract.NewGenesisState(1,
+		[]contract.Negotiation{contract.NewNegotiation("negotiation", contract.NewTerms(buyerAddress, sellerAddress, pegHash, 200, 100), buyerAddress)}))
+	genesisState[reputation.ModuleName] = cdc.MustMarshalJSON(reputation.NewGenesisState([]reputation.Record{sellerRecord}, nil, nil))
+
+	server := newTestRestServer(t, cdc, genesisState)
+	defer server.Close()
+
+	var queriedAssetPeg types.AssetPeg
+	getResult(t, cdc, server, fmt.Sprintf("/asset/assets/%s", pegHash), &queriedAssetPeg)
+	if !queriedAssetPeg.GetOwnerAddress().Equals(sellerAddress) || queriedAssetPeg.GetAssetQuantity() != 10 {
+		t.Fatalf("unexpected asset peg %s", queriedAssetPeg)
+	}
+
+	var fiatPegWallet types.FiatPegWallet
+	getResult(t, cdc, server, fmt.Sprintf("/fiat/owners/%s/fiats", buyerAddress), &fiatPegWallet)
+	if fiatPegWallet.AmountOf(buyerAddress) != 500 {
+		t.Fatalf("expected the buyer to own 500 fiat, got %d", fiatPegWallet.AmountOf(buyerAddress))
+	}
+
+	var queriedEscrow escrow.Escrow
+	getResult(t, cdc, server, "/escrow/escrows/order", &queriedEscrow)
+	if !queriedEscrow.SellerAddress.Equals(sellerAddress) || queriedEscrow.FiatAmount != 200 || queriedEscrow.Status != escrow.StatusOpen {
+		t.Fatalf("unexpected escrow %v", queriedEscrow)
+	}
+
+	var negotiation contract.Negotiation
+	getResult(t, cdc, server, "/contract/negotiations/negotiation", &negotiation)
+	if !negotiation.ProposerAddress.Equals(buyerAddress) || negotiation.Terms.Bid != 200 {
+		t.Fatalf("unexpected negotiation %s", negotiation)
+	}
+
+	var sellerReputation reputation.Reputation
+	getResult(t, cdc, server, fmt.Sprintf("/reputation/reputations/%s", sellerAddress), &sellerReputation)
+	if sellerReputation.Record.CompletedTrades != 3 {
+		t.Fatalf("unexpected reputation %v", sellerReputation)
+	}
+
+	response, err := http.Get(server.URL + "/reputation/reputations/invalid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected an invalid address to return %d, got %d", http.StatusBadRequest, response.StatusCode)
+	}
+}
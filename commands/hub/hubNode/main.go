@@ -4,15 +4,14 @@ import (
 	"encoding/json"
 	"io"
 
-	"github.com/cosmos/cosmos-sdk/cmd/gaia/app"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	tendermintABSITypes "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/cli"
-	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/libs/log"
 	tendermintTypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -50,12 +49,14 @@ func main() {
 	rootCommand.AddCommand(initialize.TestnetCommand(context, codec))
 	rootCommand.AddCommand(initialize.GenesisTransactionCommand(context, codec))
 	rootCommand.AddCommand(initialize.AddGenesisAccountCommand(context, codec))
+	rootCommand.AddCommand(initialize.AddGenesisAssetCommand(context, codec))
+	rootCommand.AddCommand(initialize.AddGenesisFiatCommand(context, codec))
 	rootCommand.AddCommand(initialize.ValidateGenesisCommand(context, codec))
 	rootCommand.AddCommand(client.NewCompletionCmd(rootCommand, true))
 
 	server.AddCommands(context, codec, rootCommand, newApplication, exportApplicationStateAndValidators)
 
-	executor := cli.PrepareBaseCmd(rootCommand, "CA", app.DefaultNodeHome)
+	executor := cli.PrepareBaseCmd(rootCommand, "CA", hub.DefaultNodeHome)
 	rootCommand.PersistentFlags().UintVar(
 		&invalidCheckPeriod,
 		flagInvalidCheckPeriod,
@@ -1,3 +1,91 @@
 package main
 
-func main() {}
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	tendermintABSITypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/cli"
+	"github.com/tendermint/tendermint/libs/log"
+	tendermintTypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+	genaccountsCLI "github.com/cosmos/cosmos-sdk/x/genaccounts/client/cli"
+	genutilCLI "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/commitHub/commitBlockchain/applications/zone"
+	"github.com/commitHub/commitBlockchain/applications/zone/initialize"
+)
+
+const flagInvalidCheckPeriod = "invalid-check-period"
+
+var invalidCheckPeriod uint
+
+func main() {
+	codec := zone.MakeCodec()
+
+	configuration := sdkTypes.GetConfig()
+	configuration.SetBech32PrefixForAccount(sdkTypes.Bech32PrefixAccAddr, sdkTypes.Bech32PrefixAccPub)
+	configuration.SetBech32PrefixForValidator(sdkTypes.Bech32PrefixValAddr, sdkTypes.Bech32PrefixValPub)
+	configuration.SetBech32PrefixForConsensusNode(sdkTypes.Bech32PrefixConsAddr, sdkTypes.Bech32PrefixConsPub)
+	configuration.Seal()
+
+	context := server.NewDefaultContext()
+	cobra.EnableCommandSorting = false
+	rootCommand := &cobra.Command{
+		Use:               "zoneNode",
+		Short:             "Commit Zone Node Daemon (server)",
+		PersistentPreRunE: server.PersistentPreRunEFn(context),
+	}
+
+	rootCommand.AddCommand(genutilCLI.InitCmd(context, codec, zone.ModuleBasics, zone.DefaultNodeHome))
+	rootCommand.AddCommand(genutilCLI.CollectGenTxsCmd(context, codec, genaccounts.AppModuleBasic{}, zone.DefaultNodeHome))
+	rootCommand.AddCommand(initialize.TestnetCommand(context, codec))
+	rootCommand.AddCommand(genutilCLI.GenTxCmd(context, codec, zone.ModuleBasics, staking.AppModuleBasic{}, genaccounts.AppModuleBasic{}, zone.DefaultNodeHome, zone.DefaultClientHome))
+	rootCommand.AddCommand(genaccountsCLI.AddGenesisAccountCmd(context, codec, zone.DefaultNodeHome, zone.DefaultClientHome))
+	rootCommand.AddCommand(initialize.AddGenesisZoneAdminCommand(context, codec))
+	rootCommand.AddCommand(genutilCLI.ValidateGenesisCmd(context, codec, zone.ModuleBasics))
+	rootCommand.AddCommand(client.NewCompletionCmd(rootCommand, true))
+
+	server.AddCommands(context, codec, rootCommand, newApplication, exportApplicationStateAndValidators)
+
+	executor := cli.PrepareBaseCmd(rootCommand, "CZ", zone.DefaultNodeHome)
+	rootCommand.PersistentFlags().UintVar(
+		&invalidCheckPeriod,
+		flagInvalidCheckPeriod,
+		0,
+		"Assert registered invariants every N blocks",
+	)
+	err := executor.Execute()
+	if err != nil {
+		panic(err)
+	}
+}
+
+func newApplication(logger log.Logger, db dbm.DB, traceStore io.Writer) tendermintABSITypes.Application {
+	return zone.NewCommitHubApplicaiton(logger, db, traceStore, true, invalidCheckPeriod, baseapp.SetPruning(store.NewPruningOptionsFromString(viper.GetString("pruning"))), baseapp.SetMinGasPrices(viper.GetString(server.FlagMinGasPrices)))
+}
+
+func exportApplicationStateAndValidators(logger log.Logger, db dbm.DB, traceStore io.Writer, height int64, forZeroHeight bool, jailWhiteList []string) (json.RawMessage, []tendermintTypes.GenesisValidator, error) {
+
+	if height != -1 {
+		genesisApplication := zone.NewCommitHubApplicaiton(logger, db, traceStore, false, uint(1))
+		err := genesisApplication.LoadHeight(height)
+		if err != nil {
+			return nil, nil, err
+		}
+		return genesisApplication.ExportApplicationStateAndValidators(forZeroHeight, jailWhiteList)
+	}
+	genesisApplication := zone.NewCommitHubApplicaiton(logger, db, traceStore, true, uint(1))
+	return genesisApplication.ExportApplicationStateAndValidators(forZeroHeight, jailWhiteList)
+}
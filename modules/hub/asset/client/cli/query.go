@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/hub/asset"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+const (
+	flagPage  = "page"
+	flagLimit = "limit"
+)
+
+func GetQueryCommand(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	command := &cobra.Command{
+		Use:                        asset.QuerierRoute,
+		Short:                      "Querying commands for assets",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	command.AddCommand(client.GetCommands(
+		QueryAssetCommand(queryRoute, cdc),
+		QueryOwnerAssetsCommand(queryRoute, cdc),
+		QueryAssetsCommand(queryRoute, cdc),
+		QueryParamsCommand(queryRoute, cdc),
+	)...)
+	return command
+}
+func QueryAssetCommand(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "asset [peg-hash]",
+		Short: "Query an asset peg by its peg hash",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			pegHash, err := types.GetPegHashHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			bytes, err := cdc.MarshalJSON(asset.NewQueryAssetParams(pegHash))
+			if err != nil {
+				return err
+			}
+			res, _, err := cliContext.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, asset.QueryAsset), bytes)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+func QueryOwnerAssetsCommand(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "owner [address]",
+		Short: "Query the asset pegs owned by an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			ownerAddress, err := sdkTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bytes, err := cdc.MarshalJSON(asset.NewQueryOwnerAssetsParams(ownerAddress))
+			if err != nil {
+				return err
+			}
+			res, _, err := cliContext.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, asset.QueryOwnerAssets), bytes)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+func QueryAssetsCommand(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "assets",
+		Short: "Query all asset pegs, one page at a time",
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			bytes, err := cdc.MarshalJSON(asset.NewQueryAssetsParams(viper.GetInt(flagPage), viper.GetInt(flagLimit)))
+			if err != nil {
+				return err
+			}
+			res, _, err := cliContext.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, asset.QueryAssets), bytes)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	command.Flags().Int(flagPage, 1, "page of results to return")
+	command.Flags().Int(flagLimit, asset.DefaultQueryLimit, "number of results per page")
+	return command
+}
+func QueryParamsCommand(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Query the asset module parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliContext.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, asset.QueryParams), nil)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
@@ -0,0 +1,180 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/commitHub/commitBlockchain/modules/hub/asset"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+func GetTxCommand(cdc *codec.Codec) *cobra.Command {
+	command := &cobra.Command{
+		Use:                        asset.RouterKey,
+		Short:                      "Asset transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	command.AddCommand(client.PostCommands(
+		IssueAssetCommand(cdc),
+		RedeemAssetCommand(cdc),
+		ConfirmAssetRedemptionCommand(cdc),
+		CancelAssetRedemptionCommand(cdc),
+		SendAssetCommand(cdc),
+		BurnAssetCommand(cdc),
+	)...)
+	return command
+}
+func IssueAssetCommand(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "issue [to-address] [document-hash] [asset-type] [asset-quantity] [quantity-unit]",
+		Short: "Issue an asset peg to an address",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(command *cobra.Command, args []string) error {
+			transactionBuilder := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			toAddress, err := sdkTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			assetQuantity, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := asset.NewMsgIssueAsset(cliContext.GetFromAddress(), toAddress, args[1], args[2], assetQuantity, args[4])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliContext, transactionBuilder, []sdkTypes.Msg{msg})
+		},
+	}
+}
+func RedeemAssetCommand(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "redeem [issuer-address] [peg-hash]",
+		Short: "Request redemption of an asset peg with its issuer",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(command *cobra.Command, args []string) error {
+			transactionBuilder := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			issuerAddress, err := sdkTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			pegHash, err := types.GetPegHashHex(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := asset.NewMsgRedeemAsset(cliContext.GetFromAddress(), issuerAddress, pegHash)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliContext, transactionBuilder, []sdkTypes.Msg{msg})
+		},
+	}
+}
+func ConfirmAssetRedemptionCommand(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "confirm-redemption [peg-hash]",
+		Short: "Confirm a pending redemption of an asset peg as its issuer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			transactionBuilder := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			pegHash, err := types.GetPegHashHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := asset.NewMsgConfirmAssetRedemption(cliContext.GetFromAddress(), pegHash)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliContext, transactionBuilder, []sdkTypes.Msg{msg})
+		},
+	}
+}
+func CancelAssetRedemptionCommand(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-redemption [peg-hash]",
+		Short: "Cancel a pending redemption of an owned asset peg",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			transactionBuilder := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			pegHash, err := types.GetPegHashHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := asset.NewMsgCancelAssetRedemption(cliContext.GetFromAddress(), pegHash)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliContext, transactionBuilder, []sdkTypes.Msg{msg})
+		},
+	}
+}
+func SendAssetCommand(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "send [to-address] [peg-hash]",
+		Short: "Send an asset peg to another address",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(command *cobra.Command, args []string) error {
+			transactionBuilder := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			toAddress, err := sdkTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			pegHash, err := types.GetPegHashHex(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := asset.NewMsgSendAsset(cliContext.GetFromAddress(), toAddress, pegHash)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliContext, transactionBuilder, []sdkTypes.Msg{msg})
+		},
+	}
+}
+func BurnAssetCommand(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "burn [peg-hash]",
+		Short: "Burn an owned asset peg",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			transactionBuilder := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			pegHash, err := types.GetPegHashHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := asset.NewMsgBurnAsset(cliContext.GetFromAddress(), pegHash)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliContext, transactionBuilder, []sdkTypes.Msg{msg})
+		},
+	}
+}
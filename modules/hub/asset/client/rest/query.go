@@ -0,0 +1,63 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/commitHub/commitBlockchain/modules/hub/asset"
+	"github.com/commitHub/commitBlockchain/types"
+	restTypes "github.com/commitHub/commitBlockchain/types/rest"
+)
+
+func registerQueryRoutes(cliContext context.CLIContext, router *mux.Router) {
+	router.HandleFunc("/asset/assets", queryAssetsHandlerFunction(cliContext)).Methods("GET")
+	router.HandleFunc("/asset/assets/{pegHash}", queryAssetHandlerFunction(cliContext)).Methods("GET")
+	router.HandleFunc("/asset/owners/{address}/assets", queryOwnerAssetsHandlerFunction(cliContext)).Methods("GET")
+	router.HandleFunc("/asset/parameters", queryParamsHandlerFunction(cliContext)).Methods("GET")
+}
+func queryAssetHandlerFunction(cliContext context.CLIContext) http.HandlerFunc {
+	return func(responseWriter http.ResponseWriter, request *http.Request) {
+		pegHash, err := types.GetPegHashHex(mux.Vars(request)["pegHash"])
+		if err != nil {
+			rest.WriteErrorResponse(responseWriter, http.StatusBadRequest, err.Error())
+			return
+		}
+		restTypes.QueryWithParams(responseWriter, request, cliContext, fmt.Sprintf("custom/%s/%s", asset.QuerierRoute, asset.QueryAsset), asset.NewQueryAssetParams(pegHash))
+	}
+}
+func queryOwnerAssetsHandlerFunction(cliContext context.CLIContext) http.HandlerFunc {
+	return func(responseWriter http.ResponseWriter, request *http.Request) {
+		ownerAddress, err := sdkTypes.AccAddressFromBech32(mux.Vars(request)["address"])
+		if err != nil {
+			rest.WriteErrorResponse(responseWriter, http.StatusBadRequest, err.Error())
+			return
+		}
+		restTypes.QueryWithParams(responseWriter, request, cliContext, fmt.Sprintf("custom/%s/%s", asset.QuerierRoute, asset.QueryOwnerAssets), asset.NewQueryOwnerAssetsParams(ownerAddress))
+	}
+}
+func queryAssetsHandlerFunction(cliContext context.CLIContext) http.HandlerFunc {
+	return func(responseWriter http.ResponseWriter, request *http.Request) {
+		page, err := restTypes.ParseIntOrDefault(request.FormValue("page"), 1)
+		if err != nil {
+			rest.WriteErrorResponse(responseWriter, http.StatusBadRequest, err.Error())
+			return
+		}
+		limit, err := restTypes.ParseIntOrDefault(request.FormValue("limit"), asset.DefaultQueryLimit)
+		if err != nil {
+			rest.WriteErrorResponse(responseWriter, http.StatusBadRequest, err.Error())
+			return
+		}
+		restTypes.QueryWithParams(responseWriter, request, cliContext, fmt.Sprintf("custom/%s/%s", asset.QuerierRoute, asset.QueryAssets), asset.NewQueryAssetsParams(page, limit))
+	}
+}
+func queryParamsHandlerFunction(cliContext context.CLIContext) http.HandlerFunc {
+	return func(responseWriter http.ResponseWriter, request *http.Request) {
+		restTypes.QueryWithParams(responseWriter, request, cliContext, fmt.Sprintf("custom/%s/%s", asset.QuerierRoute, asset.QueryParams), nil)
+	}
+}
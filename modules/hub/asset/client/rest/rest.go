@@ -0,0 +1,12 @@
+package rest
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+)
+
+func RegisterRoutes(cliContext context.CLIContext, router *mux.Router) {
+	registerQueryRoutes(cliContext, router)
+	registerTxRoutes(cliContext, router)
+}
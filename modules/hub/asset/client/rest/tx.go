@@ -0,0 +1,157 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/commitHub/commitBlockchain/modules/hub/asset"
+	"github.com/commitHub/commitBlockchain/types"
+	restTypes "github.com/commitHub/commitBlockchain/types/rest"
+)
+
+type IssueAssetRequest struct {
+	BaseRequest   rest.BaseReq        `json:"base_req"`
+	ToAddress     sdkTypes.AccAddress `json:"toAddress"`
+	DocumentHash  string              `json:"documentHash"`
+	AssetType     string              `json:"assetType"`
+	AssetQuantity int64               `json:"assetQuantity"`
+	QuantityUnit  string              `json:"quantityUnit"`
+}
+
+type RedeemAssetRequest struct {
+	BaseRequest   rest.BaseReq        `json:"base_req"`
+	IssuerAddress sdkTypes.AccAddress `json:"issuerAddress"`
+}
+
+type ConfirmAssetRedemptionRequest struct {
+	BaseRequest rest.BaseReq `json:"base_req"`
+}
+
+type CancelAssetRedemptionRequest struct {
+	BaseRequest rest.BaseReq `json:"base_req"`
+}
+
+type SendAssetRequest struct {
+	BaseRequest rest.BaseReq        `json:"base_req"`
+	ToAddress   sdkTypes.AccAddress `json:"toAddress"`
+}
+
+type BurnAssetRequest struct {
+	BaseRequest rest.BaseReq `json:"base_req"`
+}
+
+func registerTxRoutes(cliContext context.CLIContext, router *mux.Router) {
+	router.HandleFunc("/asset/assets", issueAssetHandlerFunction(cliContext)).Methods("POST")
+	router.HandleFunc("/asset/assets/{pegHash}/redeem", redeemAssetHandlerFunction(cliContext)).Methods("POST")
+	router.HandleFunc("/asset/assets/{pegHash}/confirm-redemption", confirmAssetRedemptionHandlerFunction(cliContext)).Methods("POST")
+	router.HandleFunc("/asset/assets/{pegHash}/cancel-redemption", cancelAssetRedemptionHandlerFunction(cliContext)).Methods("POST")
+	router.HandleFunc("/asset/assets/{pegHash}/send", sendAssetHandlerFunction(cliContext)).Methods("POST")
+	router.HandleFunc("/asset/assets/{pegHash}/burn", burnAssetHandlerFunction(cliContext)).Methods("POST")
+}
+func issueAssetHandlerFunction(cliContext context.CLIContext) http.HandlerFunc {
+	return func(responseWriter http.ResponseWriter, request *http.Request) {
+		var issueAssetRequest IssueAssetRequest
+		fromAddress, ok := restTypes.ReadBaseRequest(responseWriter, request, cliContext, &issueAssetRequest, &issueAssetRequest.BaseRequest)
+		if !ok {
+			return
+		}
+
+		msg := asset.NewMsgIssueAsset(fromAddress, issueAssetRequest.ToAddress, issueAssetRequest.DocumentHash, issueAssetRequest.AssetType, issueAssetRequest.AssetQuantity, issueAssetRequest.QuantityUnit)
+		restTypes.WriteGenerateStdTxResponse(responseWriter, cliContext, issueAssetRequest.BaseRequest, msg)
+	}
+}
+func redeemAssetHandlerFunction(cliContext context.CLIContext) http.HandlerFunc {
+	return func(responseWriter http.ResponseWriter, request *http.Request) {
+		pegHash, err := types.GetPegHashHex(mux.Vars(request)["pegHash"])
+		if err != nil {
+			rest.WriteErrorResponse(responseWriter, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var redeemAssetRequest RedeemAssetRequest
+		fromAddress, ok := restTypes.ReadBaseRequest(responseWriter, request, cliContext, &redeemAssetRequest, &redeemAssetRequest.BaseRequest)
+		if !ok {
+			return
+		}
+
+		msg := asset.NewMsgRedeemAsset(fromAddress, redeemAssetRequest.IssuerAddress, pegHash)
+		restTypes.WriteGenerateStdTxResponse(responseWriter, cliContext, redeemAssetRequest.BaseRequest, msg)
+	}
+}
+func confirmAssetRedemptionHandlerFunction(cliContext context.CLIContext) http.HandlerFunc {
+	return func(responseWriter http.ResponseWriter, request *http.Request) {
+		pegHash, err := types.GetPegHashHex(mux.Vars(request)["pegHash"])
+		if err != nil {
+			rest.WriteErrorResponse(responseWriter, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var confirmAssetRedemptionRequest ConfirmAssetRedemptionRequest
+		fromAddress, ok := restTypes.ReadBaseRequest(responseWriter, request, cliContext, &confirmAssetRedemptionRequest, &confirmAssetRedemptionRequest.BaseRequest)
+		if !ok {
+			return
+		}
+
+		msg := asset.NewMsgConfirmAssetRedemption(fromAddress, pegHash)
+		restTypes.WriteGenerateStdTxResponse(responseWriter, cliContext, confirmAssetRedemptionRequest.BaseRequest, msg)
+	}
+}
+func cancelAssetRedemptionHandlerFunction(cliContext context.CLIContext) http.HandlerFunc {
+	return func(responseWriter http.ResponseWriter, request *http.Request) {
+		pegHash, err := types.GetPegHashHex(mux.Vars(request)["pegHash"])
+		if err != nil {
+			rest.WriteErrorResponse(responseWriter, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var cancelAssetRedemptionRequest CancelAssetRedemptionRequest
+		fromAddress, ok := restTypes.ReadBaseRequest(responseWriter, request, cliContext, &cancelAssetRedemptionRequest, &cancelAssetRedemptionRequest.BaseRequest)
+		if !ok {
+			return
+		}
+
+		msg := asset.NewMsgCancelAssetRedemption(fromAddress, pegHash)
+		restTypes.WriteGenerateStdTxResponse(responseWriter, cliContext, cancelAssetRedemptionRequest.BaseRequest, msg)
+	}
+}
+func sendAssetHandlerFunction(cliContext context.CLIContext) http.HandlerFunc {
+	return func(responseWriter http.ResponseWriter, request *http.Request) {
+		pegHash, err := types.GetPegHashHex(mux.Vars(request)["pegHash"])
+		if err != nil {
+			rest.WriteErrorResponse(responseWriter, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var sendAssetRequest SendAssetRequest
+		fromAddress, ok := restTypes.ReadBaseRequest(responseWriter, request, cliContext, &sendAssetRequest, &sendAssetRequest.BaseRequest)
+		if !ok {
+			return
+		}
+
+		msg := asset.NewMsgSendAsset(fromAddress, sendAssetRequest.ToAddress, pegHash)
+		restTypes.WriteGenerateStdTxResponse(responseWriter, cliContext, sendAssetRequest.BaseRequest, msg)
+	}
+}
+func burnAssetHandlerFunction(cliContext context.CLIContext) http.HandlerFunc {
+	return func(responseWriter http.ResponseWriter, request *http.Request) {
+		pegHash, err := types.GetPegHashHex(mux.Vars(request)["pegHash"])
+		if err != nil {
+			rest.WriteErrorResponse(responseWriter, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var burnAssetRequest BurnAssetRequest
+		fromAddress, ok := restTypes.ReadBaseRequest(responseWriter, request, cliContext, &burnAssetRequest, &burnAssetRequest.BaseRequest)
+		if !ok {
+			return
+		}
+
+		msg := asset.NewMsgBurnAsset(fromAddress, pegHash)
+		restTypes.WriteGenerateStdTxResponse(responseWriter, cliContext, burnAssetRequest.BaseRequest, msg)
+	}
+}
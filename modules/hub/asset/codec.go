@@ -1 +1,23 @@
 package asset
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgIssueAsset{}, "commit/asset/MsgIssueAsset", nil)
+	cdc.RegisterConcrete(MsgRedeemAsset{}, "commit/asset/MsgRedeemAsset", nil)
+	cdc.RegisterConcrete(MsgConfirmAssetRedemption{}, "commit/asset/MsgConfirmAssetRedemption", nil)
+	cdc.RegisterConcrete(MsgCancelAssetRedemption{}, "commit/asset/MsgCancelAssetRedemption", nil)
+	cdc.RegisterConcrete(MsgSendAsset{}, "commit/asset/MsgSendAsset", nil)
+	cdc.RegisterConcrete(MsgBurnAsset{}, "commit/asset/MsgBurnAsset", nil)
+}
+
+var msgCdc = codec.New()
+
+func init() {
+	RegisterCodec(msgCdc)
+	types.RegisterCodec(msgCdc)
+}
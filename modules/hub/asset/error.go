@@ -1 +1,47 @@
 package asset
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+const (
+	DefaultCodespace sdkTypes.CodespaceType = "asset"
+
+	CodeAssetNotFound      sdkTypes.CodeType = 101
+	CodeUnauthorizedOwner  sdkTypes.CodeType = 102
+	CodeAssetLocked        sdkTypes.CodeType = 103
+	CodeInvalidIssuer      sdkTypes.CodeType = 104
+	CodeInvalidAsset       sdkTypes.CodeType = 105
+	CodeUnauthorizedIssuer sdkTypes.CodeType = 106
+	CodeRedemptionNotFound sdkTypes.CodeType = 107
+	CodeInvalidOrigin      sdkTypes.CodeType = 108
+)
+
+func ErrAssetNotFound(codespace sdkTypes.CodespaceType, pegHash types.PegHash) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeAssetNotFound, fmt.Sprintf("asset with peg hash %s not found", pegHash))
+}
+func ErrUnauthorizedOwner(codespace sdkTypes.CodespaceType, address sdkTypes.AccAddress, pegHash types.PegHash) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeUnauthorizedOwner, fmt.Sprintf("%s does not own asset %s", address, pegHash))
+}
+func ErrAssetLocked(codespace sdkTypes.CodespaceType, pegHash types.PegHash) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeAssetLocked, fmt.Sprintf("asset %s is locked", pegHash))
+}
+func ErrInvalidIssuer(codespace sdkTypes.CodespaceType, address sdkTypes.AccAddress, pegHash types.PegHash) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeInvalidIssuer, fmt.Sprintf("%s is not the issuer of asset %s", address, pegHash))
+}
+func ErrInvalidAsset(codespace sdkTypes.CodespaceType, message string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeInvalidAsset, message)
+}
+func ErrUnauthorizedIssuer(codespace sdkTypes.CodespaceType, address sdkTypes.AccAddress) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeUnauthorizedIssuer, fmt.Sprintf("%s is not an authorized asset issuer", address))
+}
+func ErrRedemptionNotFound(codespace sdkTypes.CodespaceType, pegHash types.PegHash) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeRedemptionNotFound, fmt.Sprintf("no pending redemption for asset %s", pegHash))
+}
+func ErrInvalidOrigin(codespace sdkTypes.CodespaceType, msg string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeInvalidOrigin, msg)
+}
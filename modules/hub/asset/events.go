@@ -0,0 +1,9 @@
+package asset
+
+var (
+	AttributeKeyPegHash   = "pegHash"
+	AttributeKeySender    = "sender"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyIssuer    = "issuer"
+	AttributeKeyOwner     = "owner"
+)
@@ -1 +1,109 @@
 package asset
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+type GenesisAssetPeg struct {
+	AssetPeg        types.AssetPeg      `json:"assetPeg"`
+	IssuerAddress   sdkTypes.AccAddress `json:"issuerAddress"`
+	RedeemerAddress sdkTypes.AccAddress `json:"redeemerAddress"`
+	Origin          AssetOrigin         `json:"origin"`
+}
+
+func NewGenesisAssetPeg(assetPeg types.AssetPeg, issuerAddress sdkTypes.AccAddress, redeemerAddress sdkTypes.AccAddress, assetOrigin AssetOrigin) GenesisAssetPeg {
+	return GenesisAssetPeg{
+		AssetPeg:        assetPeg,
+		IssuerAddress:   issuerAddress,
+		RedeemerAddress: redeemerAddress,
+		Origin:          assetOrigin,
+	}
+}
+
+type GenesisState struct {
+	Params         Params            `json:"params"`
+	PegHashCounter uint64            `json:"pegHashCounter"`
+	AssetPegs      []GenesisAssetPeg `json:"assetPegs"`
+}
+
+func NewGenesisState(params Params, pegHashCounter uint64, assetPegs []GenesisAssetPeg) GenesisState {
+	return GenesisState{
+		Params:         params,
+		PegHashCounter: pegHashCounter,
+		AssetPegs:      assetPegs,
+	}
+}
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), 0, []GenesisAssetPeg{})
+}
+func InitGenesis(ctx sdkTypes.Context, keeper Keeper, genesisState GenesisState) {
+	keeper.SetParams(ctx, genesisState.Params)
+	for _, genesisAssetPeg := range genesisState.AssetPegs {
+		keeper.SetAssetPeg(ctx, genesisAssetPeg.AssetPeg)
+		keeper.setIssuer(ctx, genesisAssetPeg.AssetPeg.GetPegHash(), genesisAssetPeg.IssuerAddress)
+		if !genesisAssetPeg.RedeemerAddress.Empty() {
+			keeper.setRedeemer(ctx, genesisAssetPeg.AssetPeg.GetPegHash(), genesisAssetPeg.RedeemerAddress)
+		}
+		if !genesisAssetPeg.Origin.Empty() {
+			keeper.setAssetOrigin(ctx, genesisAssetPeg.AssetPeg.GetPegHash(), genesisAssetPeg.Origin)
+		}
+	}
+	keeper.setPegHashCounter(ctx, genesisState.PegHashCounter)
+}
+func ExportGenesis(ctx sdkTypes.Context, keeper Keeper) GenesisState {
+	var assetPegs []GenesisAssetPeg
+	keeper.IterateAssetPegs(ctx, func(assetPeg types.AssetPeg) (stop bool) {
+		pegHash := assetPeg.GetPegHash()
+		assetOrigin, _ := keeper.GetAssetOrigin(ctx, pegHash)
+		assetPegs = append(assetPegs, NewGenesisAssetPeg(assetPeg, keeper.GetIssuer(ctx, pegHash), keeper.GetRedeemer(ctx, pegHash), assetOrigin))
+		return false
+	})
+	return NewGenesisState(keeper.GetParams(ctx), keeper.getPegHashCounter(ctx), assetPegs)
+}
+func ValidateGenesis(genesisState GenesisState) error {
+	if err := genesisState.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenPegHashes := make(map[string]bool)
+	seenOrigins := make(map[string]bool)
+	for _, genesisAssetPeg := range genesisState.AssetPegs {
+		if genesisAssetPeg.AssetPeg == nil {
+			return fmt.Errorf("asset genesis contains an empty asset peg")
+		}
+		if err := genesisAssetPeg.AssetPeg.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid asset peg %s: %s", genesisAssetPeg.AssetPeg.GetPegHash(), err.Error())
+		}
+
+		pegHash := genesisAssetPeg.AssetPeg.GetPegHash()
+		if seenPegHashes[pegHash.String()] {
+			return fmt.Errorf("duplicate asset peg %s", pegHash)
+		}
+		seenPegHashes[pegHash.String()] = true
+
+		if genesisAssetPeg.IssuerAddress.Empty() {
+			return fmt.Errorf("asset peg %s has an empty issuer address", pegHash)
+		}
+		if !genesisAssetPeg.RedeemerAddress.Empty() && !genesisAssetPeg.RedeemerAddress.Equals(genesisAssetPeg.AssetPeg.GetOwnerAddress()) {
+			return fmt.Errorf("asset peg %s is pending redemption by %s which is not its owner", pegHash, genesisAssetPeg.RedeemerAddress)
+		}
+		if origin := genesisAssetPeg.Origin; !origin.Empty() {
+			if origin.PegHash.Empty() {
+				return fmt.Errorf("asset peg %s has an origin without a peg hash", pegHash)
+			}
+			if seenOrigins[origin.String()] {
+				return fmt.Errorf("duplicate asset origin %s", origin)
+			}
+			seenOrigins[origin.String()] = true
+		}
+		if len(pegHash) == 8 && binary.BigEndian.Uint64(pegHash) > genesisState.PegHashCounter {
+			return fmt.Errorf("asset peg %s is ahead of the peg hash counter %d", pegHash, genesisState.PegHashCounter)
+		}
+	}
+	return nil
+}
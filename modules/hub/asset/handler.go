@@ -1 +1,135 @@
 package asset
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+func NewHandler(keeper Keeper) sdkTypes.Handler {
+	return func(ctx sdkTypes.Context, msg sdkTypes.Msg) sdkTypes.Result {
+		switch msg := msg.(type) {
+		case MsgIssueAsset:
+			return handleMsgIssueAsset(ctx, keeper, msg)
+		case MsgRedeemAsset:
+			return handleMsgRedeemAsset(ctx, keeper, msg)
+		case MsgConfirmAssetRedemption:
+			return handleMsgConfirmAssetRedemption(ctx, keeper, msg)
+		case MsgCancelAssetRedemption:
+			return handleMsgCancelAssetRedemption(ctx, keeper, msg)
+		case MsgSendAsset:
+			return handleMsgSendAsset(ctx, keeper, msg)
+		case MsgBurnAsset:
+			return handleMsgBurnAsset(ctx, keeper, msg)
+		default:
+			errMsg := fmt.Sprintf("unrecognized asset message type: %T", msg)
+			return sdkTypes.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+func handleMsgIssueAsset(ctx sdkTypes.Context, keeper Keeper, msg MsgIssueAsset) sdkTypes.Result {
+	baseAssetPeg := types.NewBaseAssetPeg(nil, msg.DocumentHash, msg.AssetType, msg.AssetQuantity, msg.QuantityUnit, msg.ToAddress)
+	assetPeg, err := keeper.IssueAsset(ctx, msg.IssuerAddress, &baseAssetPeg)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			msg.Type(),
+			sdkTypes.NewAttribute(AttributeKeyIssuer, msg.IssuerAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyRecipient, msg.ToAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyPegHash, assetPeg.GetPegHash().String()),
+		),
+	)
+	return sdkTypes.Result{
+		Data:   assetPeg.GetPegHash(),
+		Events: ctx.EventManager().Events(),
+	}
+}
+func handleMsgRedeemAsset(ctx sdkTypes.Context, keeper Keeper, msg MsgRedeemAsset) sdkTypes.Result {
+	if err := keeper.RedeemAsset(ctx, msg.RedeemerAddress, msg.IssuerAddress, msg.PegHash); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			msg.Type(),
+			sdkTypes.NewAttribute(AttributeKeyOwner, msg.RedeemerAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyIssuer, msg.IssuerAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyPegHash, msg.PegHash.String()),
+		),
+	)
+	return sdkTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+func handleMsgConfirmAssetRedemption(ctx sdkTypes.Context, keeper Keeper, msg MsgConfirmAssetRedemption) sdkTypes.Result {
+	redeemerAddress, err := keeper.ConfirmAssetRedemption(ctx, msg.IssuerAddress, msg.PegHash)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			msg.Type(),
+			sdkTypes.NewAttribute(AttributeKeyOwner, redeemerAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyIssuer, msg.IssuerAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyPegHash, msg.PegHash.String()),
+		),
+	)
+	return sdkTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+func handleMsgCancelAssetRedemption(ctx sdkTypes.Context, keeper Keeper, msg MsgCancelAssetRedemption) sdkTypes.Result {
+	if err := keeper.CancelAssetRedemption(ctx, msg.RedeemerAddress, msg.PegHash); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			msg.Type(),
+			sdkTypes.NewAttribute(AttributeKeyOwner, msg.RedeemerAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyPegHash, msg.PegHash.String()),
+		),
+	)
+	return sdkTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+func handleMsgSendAsset(ctx sdkTypes.Context, keeper Keeper, msg MsgSendAsset) sdkTypes.Result {
+	if err := keeper.SendAsset(ctx, msg.FromAddress, msg.ToAddress, msg.PegHash); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			msg.Type(),
+			sdkTypes.NewAttribute(AttributeKeySender, msg.FromAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyRecipient, msg.ToAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyPegHash, msg.PegHash.String()),
+		),
+	)
+	return sdkTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+func handleMsgBurnAsset(ctx sdkTypes.Context, keeper Keeper, msg MsgBurnAsset) sdkTypes.Result {
+	if err := keeper.BurnAsset(ctx, msg.OwnerAddress, msg.PegHash); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			msg.Type(),
+			sdkTypes.NewAttribute(AttributeKeyOwner, msg.OwnerAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyPegHash, msg.PegHash.String()),
+		),
+	)
+	return sdkTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
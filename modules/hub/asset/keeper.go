@@ -1 +1,301 @@
 package asset
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+const (
+	StoreKey     = "asset"
+	QuerierRoute = "asset"
+)
+
+var (
+	PegHashCounterKey      = []byte{0x00}
+	AssetPegKeyPrefix      = []byte{0x01}
+	OwnerAssetPegKeyPrefix = []byte{0x02}
+	IssuerKeyPrefix        = []byte{0x03}
+	RedemptionKeyPrefix    = []byte{0x04}
+	OriginKeyPrefix        = []byte{0x05}
+	VoucherKeyPrefix       = []byte{0x06}
+)
+
+func GetAssetPegKey(pegHash types.PegHash) []byte {
+	return append(AssetPegKeyPrefix, pegHash.Bytes()...)
+}
+func GetOwnerAssetPegsKey(ownerAddress sdkTypes.AccAddress) []byte {
+	return append(OwnerAssetPegKeyPrefix, ownerAddress.Bytes()...)
+}
+func GetOwnerAssetPegKey(ownerAddress sdkTypes.AccAddress, pegHash types.PegHash) []byte {
+	return append(GetOwnerAssetPegsKey(ownerAddress), pegHash.Bytes()...)
+}
+func GetIssuerKey(pegHash types.PegHash) []byte {
+	return append(IssuerKeyPrefix, pegHash.Bytes()...)
+}
+func GetRedemptionKey(pegHash types.PegHash) []byte {
+	return append(RedemptionKeyPrefix, pegHash.Bytes()...)
+}
+func GetOriginKey(pegHash types.PegHash) []byte {
+	return append(OriginKeyPrefix, pegHash.Bytes()...)
+}
+func GetVoucherKey(assetOrigin AssetOrigin) []byte {
+	return append(append(append(VoucherKeyPrefix, []byte(assetOrigin.ChainID)...), '/'), assetOrigin.PegHash.Bytes()...)
+}
+
+type Keeper struct {
+	storeKey   sdkTypes.StoreKey
+	cdc        *codec.Codec
+	paramSpace params.Subspace
+	codespace  sdkTypes.CodespaceType
+}
+
+func NewKeeper(cdc *codec.Codec, storeKey sdkTypes.StoreKey, paramSpace params.Subspace, codespace sdkTypes.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:   storeKey,
+		cdc:        cdc,
+		paramSpace: paramSpace.WithKeyTable(ParamKeyTable()),
+		codespace:  codespace,
+	}
+}
+func (keeper Keeper) Codespace() sdkTypes.CodespaceType {
+	return keeper.codespace
+}
+func (keeper Keeper) GetParams(ctx sdkTypes.Context) Params {
+	params := DefaultParams()
+	keeper.paramSpace.GetIfExists(ctx, ParamStoreKeyParams, &params)
+	return params
+}
+func (keeper Keeper) SetParams(ctx sdkTypes.Context, params Params) {
+	keeper.paramSpace.Set(ctx, ParamStoreKeyParams, &params)
+}
+func (keeper Keeper) getPegHashCounter(ctx sdkTypes.Context) uint64 {
+	store := ctx.KVStore(keeper.storeKey)
+	counterBytes := store.Get(PegHashCounterKey)
+	if counterBytes == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(counterBytes)
+}
+func (keeper Keeper) setPegHashCounter(ctx sdkTypes.Context, counter uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	counterBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(counterBytes, counter)
+	store.Set(PegHashCounterKey, counterBytes)
+}
+func (keeper Keeper) getNextPegHash(ctx sdkTypes.Context) types.PegHash {
+	counter := keeper.getPegHashCounter(ctx) + 1
+	keeper.setPegHashCounter(ctx, counter)
+
+	pegHash := make([]byte, 8)
+	binary.BigEndian.PutUint64(pegHash, counter)
+	return pegHash
+}
+func (keeper Keeper) GetAssetPeg(ctx sdkTypes.Context, pegHash types.PegHash) (types.AssetPeg, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	assetPegBytes := store.Get(GetAssetPegKey(pegHash))
+	if assetPegBytes == nil {
+		return nil, false
+	}
+
+	var assetPeg types.AssetPeg
+	keeper.cdc.MustUnmarshalBinaryBare(assetPegBytes, &assetPeg)
+	return assetPeg, true
+}
+func (keeper Keeper) SetAssetPeg(ctx sdkTypes.Context, assetPeg types.AssetPeg) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	if oldAssetPeg, found := keeper.GetAssetPeg(ctx, assetPeg.GetPegHash()); found {
+		store.Delete(GetOwnerAssetPegKey(oldAssetPeg.GetOwnerAddress(), oldAssetPeg.GetPegHash()))
+	}
+
+	store.Set(GetAssetPegKey(assetPeg.GetPegHash()), keeper.cdc.MustMarshalBinaryBare(assetPeg))
+	store.Set(GetOwnerAssetPegKey(assetPeg.GetOwnerAddress(), assetPeg.GetPegHash()), []byte{})
+}
+func (keeper Keeper) RemoveAssetPeg(ctx sdkTypes.Context, pegHash types.PegHash) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	if assetPeg, found := keeper.GetAssetPeg(ctx, pegHash); found {
+		store.Delete(GetOwnerAssetPegKey(assetPeg.GetOwnerAddress(), pegHash))
+	}
+	if assetOrigin, found := keeper.GetAssetOrigin(ctx, pegHash); found {
+		store.Delete(GetVoucherKey(assetOrigin))
+	}
+	store.Delete(GetAssetPegKey(pegHash))
+	store.Delete(GetIssuerKey(pegHash))
+	store.Delete(GetRedemptionKey(pegHash))
+	store.Delete(GetOriginKey(pegHash))
+}
+func (keeper Keeper) GetIssuer(ctx sdkTypes.Context, pegHash types.PegHash) sdkTypes.AccAddress {
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Get(GetIssuerKey(pegHash))
+}
+func (keeper Keeper) setIssuer(ctx sdkTypes.Context, pegHash types.PegHash, issuerAddress sdkTypes.AccAddress) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(GetIssuerKey(pegHash), issuerAddress.Bytes())
+}
+func (keeper Keeper) GetRedeemer(ctx sdkTypes.Context, pegHash types.PegHash) sdkTypes.AccAddress {
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Get(GetRedemptionKey(pegHash))
+}
+func (keeper Keeper) setRedeemer(ctx sdkTypes.Context, pegHash types.PegHash, redeemerAddress sdkTypes.AccAddress) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(GetRedemptionKey(pegHash), redeemerAddress.Bytes())
+}
+func (keeper Keeper) GetAssetOrigin(ctx sdkTypes.Context, pegHash types.PegHash) (AssetOrigin, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	assetOriginBytes := store.Get(GetOriginKey(pegHash))
+	if assetOriginBytes == nil {
+		return AssetOrigin{}, false
+	}
+
+	var assetOrigin AssetOrigin
+	keeper.cdc.MustUnmarshalBinaryBare(assetOriginBytes, &assetOrigin)
+	return assetOrigin, true
+}
+func (keeper Keeper) setAssetOrigin(ctx sdkTypes.Context, pegHash types.PegHash, assetOrigin AssetOrigin) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(GetOriginKey(pegHash), keeper.cdc.MustMarshalBinaryBare(assetOrigin))
+	store.Set(GetVoucherKey(assetOrigin), pegHash.Bytes())
+}
+func (keeper Keeper) GetVoucherPegHash(ctx sdkTypes.Context, assetOrigin AssetOrigin) (types.PegHash, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	pegHash := store.Get(GetVoucherKey(assetOrigin))
+	return pegHash, pegHash != nil
+}
+func (keeper Keeper) GetAssetPegsByOwner(ctx sdkTypes.Context, ownerAddress sdkTypes.AccAddress) []types.AssetPeg {
+	store := ctx.KVStore(keeper.storeKey)
+	ownerPrefix := GetOwnerAssetPegsKey(ownerAddress)
+	iterator := sdkTypes.KVStorePrefixIterator(store, ownerPrefix)
+	defer iterator.Close()
+
+	var assetPegs []types.AssetPeg
+	for ; iterator.Valid(); iterator.Next() {
+		pegHash := types.PegHash(iterator.Key()[len(ownerPrefix):])
+		if assetPeg, found := keeper.GetAssetPeg(ctx, pegHash); found {
+			assetPegs = append(assetPegs, assetPeg)
+		}
+	}
+	return assetPegs
+}
+func (keeper Keeper) IterateAssetPegs(ctx sdkTypes.Context, handler func(assetPeg types.AssetPeg) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdkTypes.KVStorePrefixIterator(store, AssetPegKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var assetPeg types.AssetPeg
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &assetPeg)
+		if handler(assetPeg) {
+			break
+		}
+	}
+}
+func (keeper Keeper) getOwnedAssetPeg(ctx sdkTypes.Context, ownerAddress sdkTypes.AccAddress, pegHash types.PegHash) (types.AssetPeg, sdkTypes.Error) {
+	assetPeg, found := keeper.GetAssetPeg(ctx, pegHash)
+	if !found {
+		return nil, ErrAssetNotFound(keeper.codespace, pegHash)
+	}
+	if !assetPeg.GetOwnerAddress().Equals(ownerAddress) {
+		return nil, ErrUnauthorizedOwner(keeper.codespace, ownerAddress, pegHash)
+	}
+	if assetPeg.GetLocked() {
+		return nil, ErrAssetLocked(keeper.codespace, pegHash)
+	}
+	return assetPeg, nil
+}
+func (keeper Keeper) IssueAsset(ctx sdkTypes.Context, issuerAddress sdkTypes.AccAddress, assetPeg types.AssetPeg) (types.AssetPeg, sdkTypes.Error) {
+	if !keeper.GetParams(ctx).IsIssuer(issuerAddress) {
+		return nil, ErrUnauthorizedIssuer(keeper.codespace, issuerAddress)
+	}
+	return keeper.mintAsset(ctx, issuerAddress, assetPeg)
+}
+func (keeper Keeper) mintAsset(ctx sdkTypes.Context, issuerAddress sdkTypes.AccAddress, assetPeg types.AssetPeg) (types.AssetPeg, sdkTypes.Error) {
+	if err := assetPeg.SetPegHash(keeper.getNextPegHash(ctx)); err != nil {
+		return nil, ErrInvalidAsset(keeper.codespace, err.Error())
+	}
+	if err := assetPeg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	keeper.SetAssetPeg(ctx, assetPeg)
+	keeper.setIssuer(ctx, assetPeg.GetPegHash(), issuerAddress)
+	return assetPeg, nil
+}
+func (keeper Keeper) SendAsset(ctx sdkTypes.Context, fromAddress sdkTypes.AccAddress, toAddress sdkTypes.AccAddress, pegHash types.PegHash) sdkTypes.Error {
+	assetPeg, err := keeper.getOwnedAssetPeg(ctx, fromAddress, pegHash)
+	if err != nil {
+		return err
+	}
+
+	_ = assetPeg.SetOwnerAddress(toAddress)
+	keeper.SetAssetPeg(ctx, assetPeg)
+	return nil
+}
+func (keeper Keeper) RedeemAsset(ctx sdkTypes.Context, redeemerAddress sdkTypes.AccAddress, issuerAddress sdkTypes.AccAddress, pegHash types.PegHash) sdkTypes.Error {
+	assetPeg, err := keeper.getOwnedAssetPeg(ctx, redeemerAddress, pegHash)
+	if err != nil {
+		return err
+	}
+	if !keeper.GetIssuer(ctx, pegHash).Equals(issuerAddress) {
+		return ErrInvalidIssuer(keeper.codespace, issuerAddress, pegHash)
+	}
+
+	_ = assetPeg.SetLocked(true)
+	keeper.SetAssetPeg(ctx, assetPeg)
+	keeper.setRedeemer(ctx, pegHash, redeemerAddress)
+	return nil
+}
+func (keeper Keeper) ConfirmAssetRedemption(ctx sdkTypes.Context, issuerAddress sdkTypes.AccAddress, pegHash types.PegHash) (sdkTypes.AccAddress, sdkTypes.Error) {
+	redeemerAddress := keeper.GetRedeemer(ctx, pegHash)
+	if redeemerAddress.Empty() {
+		return nil, ErrRedemptionNotFound(keeper.codespace, pegHash)
+	}
+	if !keeper.GetIssuer(ctx, pegHash).Equals(issuerAddress) {
+		return nil, ErrInvalidIssuer(keeper.codespace, issuerAddress, pegHash)
+	}
+
+	keeper.RemoveAssetPeg(ctx, pegHash)
+	return redeemerAddress, nil
+}
+func (keeper Keeper) CancelAssetRedemption(ctx sdkTypes.Context, redeemerAddress sdkTypes.AccAddress, pegHash types.PegHash) sdkTypes.Error {
+	if !keeper.GetRedeemer(ctx, pegHash).Equals(redeemerAddress) {
+		return ErrRedemptionNotFound(keeper.codespace, pegHash)
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(GetRedemptionKey(pegHash))
+	return keeper.UnlockAsset(ctx, pegHash)
+}
+func (keeper Keeper) BurnAsset(ctx sdkTypes.Context, ownerAddress sdkTypes.AccAddress, pegHash types.PegHash) sdkTypes.Error {
+	if _, err := keeper.getOwnedAssetPeg(ctx, ownerAddress, pegHash); err != nil {
+		return err
+	}
+
+	keeper.RemoveAssetPeg(ctx, pegHash)
+	return nil
+}
+func (keeper Keeper) LockAsset(ctx sdkTypes.Context, ownerAddress sdkTypes.AccAddress, pegHash types.PegHash) sdkTypes.Error {
+	assetPeg, err := keeper.getOwnedAssetPeg(ctx, ownerAddress, pegHash)
+	if err != nil {
+		return err
+	}
+
+	_ = assetPeg.SetLocked(true)
+	keeper.SetAssetPeg(ctx, assetPeg)
+	return nil
+}
+func (keeper Keeper) UnlockAsset(ctx sdkTypes.Context, pegHash types.PegHash) sdkTypes.Error {
+	assetPeg, found := keeper.GetAssetPeg(ctx, pegHash)
+	if !found {
+		return ErrAssetNotFound(keeper.codespace, pegHash)
+	}
+
+	_ = assetPeg.SetLocked(false)
+	keeper.SetAssetPeg(ctx, assetPeg)
+	return nil
+}
@@ -1 +1,227 @@
 package asset
+
+import (
+	"strings"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+const RouterKey = "asset"
+
+type MsgIssueAsset struct {
+	IssuerAddress sdkTypes.AccAddress `json:"issuerAddress"`
+	ToAddress     sdkTypes.AccAddress `json:"toAddress"`
+	DocumentHash  string              `json:"documentHash"`
+	AssetType     string              `json:"assetType"`
+	AssetQuantity int64               `json:"assetQuantity"`
+	QuantityUnit  string              `json:"quantityUnit"`
+}
+
+var _ sdkTypes.Msg = MsgIssueAsset{}
+
+func NewMsgIssueAsset(issuerAddress sdkTypes.AccAddress, toAddress sdkTypes.AccAddress, documentHash string, assetType string, assetQuantity int64, quantityUnit string) MsgIssueAsset {
+	return MsgIssueAsset{
+		IssuerAddress: issuerAddress,
+		ToAddress:     toAddress,
+		DocumentHash:  documentHash,
+		AssetType:     assetType,
+		AssetQuantity: assetQuantity,
+		QuantityUnit:  quantityUnit,
+	}
+}
+func (msg MsgIssueAsset) Route() string { return RouterKey }
+func (msg MsgIssueAsset) Type() string  { return "issueAsset" }
+func (msg MsgIssueAsset) ValidateBasic() sdkTypes.Error {
+	if msg.IssuerAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing issuer address")
+	}
+	if msg.ToAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing recipient address")
+	}
+	if len(strings.TrimSpace(msg.DocumentHash)) == 0 {
+		return ErrInvalidAsset(DefaultCodespace, "document hash cannot be empty")
+	}
+	if len(strings.TrimSpace(msg.AssetType)) == 0 {
+		return ErrInvalidAsset(DefaultCodespace, "asset type cannot be empty")
+	}
+	if msg.AssetQuantity <= 0 {
+		return ErrInvalidAsset(DefaultCodespace, "asset quantity must be positive")
+	}
+	if len(strings.TrimSpace(msg.QuantityUnit)) == 0 {
+		return ErrInvalidAsset(DefaultCodespace, "quantity unit cannot be empty")
+	}
+	return nil
+}
+func (msg MsgIssueAsset) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+func (msg MsgIssueAsset) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.IssuerAddress}
+}
+
+type MsgRedeemAsset struct {
+	RedeemerAddress sdkTypes.AccAddress `json:"redeemerAddress"`
+	IssuerAddress   sdkTypes.AccAddress `json:"issuerAddress"`
+	PegHash         types.PegHash       `json:"pegHash"`
+}
+
+var _ sdkTypes.Msg = MsgRedeemAsset{}
+
+func NewMsgRedeemAsset(redeemerAddress sdkTypes.AccAddress, issuerAddress sdkTypes.AccAddress, pegHash types.PegHash) MsgRedeemAsset {
+	return MsgRedeemAsset{
+		RedeemerAddress: redeemerAddress,
+		IssuerAddress:   issuerAddress,
+		PegHash:         pegHash,
+	}
+}
+func (msg MsgRedeemAsset) Route() string { return RouterKey }
+func (msg MsgRedeemAsset) Type() string  { return "redeemAsset" }
+func (msg MsgRedeemAsset) ValidateBasic() sdkTypes.Error {
+	if msg.RedeemerAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing redeemer address")
+	}
+	if msg.IssuerAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing issuer address")
+	}
+	if msg.PegHash.Empty() {
+		return types.ErrInvalidPegHash("peg hash cannot be empty")
+	}
+	return nil
+}
+func (msg MsgRedeemAsset) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+func (msg MsgRedeemAsset) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.RedeemerAddress}
+}
+
+type MsgConfirmAssetRedemption struct {
+	IssuerAddress sdkTypes.AccAddress `json:"issuerAddress"`
+	PegHash       types.PegHash       `json:"pegHash"`
+}
+
+var _ sdkTypes.Msg = MsgConfirmAssetRedemption{}
+
+func NewMsgConfirmAssetRedemption(issuerAddress sdkTypes.AccAddress, pegHash types.PegHash) MsgConfirmAssetRedemption {
+	return MsgConfirmAssetRedemption{
+		IssuerAddress: issuerAddress,
+		PegHash:       pegHash,
+	}
+}
+func (msg MsgConfirmAssetRedemption) Route() string { return RouterKey }
+func (msg MsgConfirmAssetRedemption) Type() string  { return "confirmAssetRedemption" }
+func (msg MsgConfirmAssetRedemption) ValidateBasic() sdkTypes.Error {
+	if msg.IssuerAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing issuer address")
+	}
+	if msg.PegHash.Empty() {
+		return types.ErrInvalidPegHash("peg hash cannot be empty")
+	}
+	return nil
+}
+func (msg MsgConfirmAssetRedemption) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+func (msg MsgConfirmAssetRedemption) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.IssuerAddress}
+}
+
+type MsgCancelAssetRedemption struct {
+	RedeemerAddress sdkTypes.AccAddress `json:"redeemerAddress"`
+	PegHash         types.PegHash       `json:"pegHash"`
+}
+
+var _ sdkTypes.Msg = MsgCancelAssetRedemption{}
+
+func NewMsgCancelAssetRedemption(redeemerAddress sdkTypes.AccAddress, pegHash types.PegHash) MsgCancelAssetRedemption {
+	return MsgCancelAssetRedemption{
+		RedeemerAddress: redeemerAddress,
+		PegHash:         pegHash,
+	}
+}
+func (msg MsgCancelAssetRedemption) Route() string { return RouterKey }
+func (msg MsgCancelAssetRedemption) Type() string  { return "cancelAssetRedemption" }
+func (msg MsgCancelAssetRedemption) ValidateBasic() sdkTypes.Error {
+	if msg.RedeemerAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing redeemer address")
+	}
+	if msg.PegHash.Empty() {
+		return types.ErrInvalidPegHash("peg hash cannot be empty")
+	}
+	return nil
+}
+func (msg MsgCancelAssetRedemption) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+func (msg MsgCancelAssetRedemption) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.RedeemerAddress}
+}
+
+type MsgSendAsset struct {
+	FromAddress sdkTypes.AccAddress `json:"fromAddress"`
+	ToAddress   sdkTypes.AccAddress `json:"toAddress"`
+	PegHash     types.PegHash       `json:"pegHash"`
+}
+
+var _ sdkTypes.Msg = MsgSendAsset{}
+
+func NewMsgSendAsset(fromAddress sdkTypes.AccAddress, toAddress sdkTypes.AccAddress, pegHash types.PegHash) MsgSendAsset {
+	return MsgSendAsset{
+		FromAddress: fromAddress,
+		ToAddress:   toAddress,
+		PegHash:     pegHash,
+	}
+}
+func (msg MsgSendAsset) Route() string { return RouterKey }
+func (msg MsgSendAsset) Type() string  { return "sendAsset" }
+func (msg MsgSendAsset) ValidateBasic() sdkTypes.Error {
+	if msg.FromAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing sender address")
+	}
+	if msg.ToAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing recipient address")
+	}
+	if msg.PegHash.Empty() {
+		return types.ErrInvalidPegHash("peg hash cannot be empty")
+	}
+	return nil
+}
+func (msg MsgSendAsset) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+func (msg MsgSendAsset) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.FromAddress}
+}
+
+type MsgBurnAsset struct {
+	OwnerAddress sdkTypes.AccAddress `json:"ownerAddress"`
+	PegHash      types.PegHash       `json:"pegHash"`
+}
+
+var _ sdkTypes.Msg = MsgBurnAsset{}
+
+func NewMsgBurnAsset(ownerAddress sdkTypes.AccAddress, pegHash types.PegHash) MsgBurnAsset {
+	return MsgBurnAsset{
+		OwnerAddress: ownerAddress,
+		PegHash:      pegHash,
+	}
+}
+func (msg MsgBurnAsset) Route() string { return RouterKey }
+func (msg MsgBurnAsset) Type() string  { return "burnAsset" }
+func (msg MsgBurnAsset) ValidateBasic() sdkTypes.Error {
+	if msg.OwnerAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing owner address")
+	}
+	if msg.PegHash.Empty() {
+		return types.ErrInvalidPegHash("peg hash cannot be empty")
+	}
+	return nil
+}
+func (msg MsgBurnAsset) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+func (msg MsgBurnAsset) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.OwnerAddress}
+}
@@ -0,0 +1,25 @@
+package asset
+
+import (
+	"fmt"
+
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+type AssetOrigin struct {
+	ChainID string        `json:"chainID"`
+	PegHash types.PegHash `json:"pegHash"`
+}
+
+func NewAssetOrigin(chainID string, pegHash types.PegHash) AssetOrigin {
+	return AssetOrigin{
+		ChainID: chainID,
+		PegHash: pegHash,
+	}
+}
+func (assetOrigin AssetOrigin) Empty() bool {
+	return len(assetOrigin.ChainID) == 0
+}
+func (assetOrigin AssetOrigin) String() string {
+	return fmt.Sprintf("%s/%s", assetOrigin.ChainID, assetOrigin.PegHash)
+}
@@ -0,0 +1,102 @@
+package asset
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/transfer"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+var _ transfer.PegHandler = Keeper{}
+
+func (keeper Keeper) getPacketPegHash(ctx sdkTypes.Context, packet transfer.Packet) (types.PegHash, sdkTypes.Error) {
+	if packet.OriginChainID != packet.DestinationChainID {
+		return packet.AssetPeg.PegHash, nil
+	}
+
+	pegHash, found := keeper.GetVoucherPegHash(ctx, NewAssetOrigin(packet.OriginChainID, packet.AssetPeg.PegHash))
+	if !found {
+		return nil, ErrAssetNotFound(keeper.codespace, packet.AssetPeg.PegHash)
+	}
+	return pegHash, nil
+}
+func (keeper Keeper) OnSendPacket(ctx sdkTypes.Context, packet transfer.Packet) (transfer.Packet, sdkTypes.Error) {
+	pegHash := packet.AssetPeg.PegHash
+	originChainID, originPegHash := ctx.ChainID(), pegHash
+	if assetOrigin, found := keeper.GetAssetOrigin(ctx, pegHash); found {
+		if assetOrigin.ChainID != packet.DestinationChainID {
+			return transfer.Packet{}, ErrInvalidOrigin(keeper.codespace, fmt.Sprintf("asset %s was issued on %s and can only be sent back there", pegHash, assetOrigin.ChainID))
+		}
+		originChainID, originPegHash = assetOrigin.ChainID, assetOrigin.PegHash
+	}
+	if err := keeper.LockAsset(ctx, packet.SenderAddress, pegHash); err != nil {
+		return transfer.Packet{}, err
+	}
+
+	assetPeg, _ := keeper.GetAssetPeg(ctx, pegHash)
+	packet.AssetPeg = types.NewBaseAssetPeg(originPegHash, assetPeg.GetDocumentHash(), assetPeg.GetAssetType(), assetPeg.GetAssetQuantity(), assetPeg.GetQuantityUnit(), assetPeg.GetOwnerAddress())
+	packet.OriginChainID = originChainID
+	return packet, nil
+}
+func (keeper Keeper) OnReceivePacket(ctx sdkTypes.Context, packet transfer.Packet) sdkTypes.Error {
+	switch packet.OriginChainID {
+	case ctx.ChainID():
+		escrowAddress := transfer.GetEscrowAddress(packet.SourceChainID)
+		assetPeg, found := keeper.GetAssetPeg(ctx, packet.AssetPeg.PegHash)
+		if !found {
+			return ErrAssetNotFound(keeper.codespace, packet.AssetPeg.PegHash)
+		}
+		if !assetPeg.GetOwnerAddress().Equals(escrowAddress) {
+			return ErrUnauthorizedOwner(keeper.codespace, escrowAddress, packet.AssetPeg.PegHash)
+		}
+
+		_ = assetPeg.SetOwnerAddress(packet.ReceiverAddress)
+		_ = assetPeg.SetLocked(false)
+		keeper.SetAssetPeg(ctx, assetPeg)
+		return nil
+	case packet.SourceChainID:
+		assetOrigin := NewAssetOrigin(packet.SourceChainID, packet.AssetPeg.PegHash)
+		if _, found := keeper.GetVoucherPegHash(ctx, assetOrigin); found {
+			return ErrInvalidOrigin(keeper.codespace, fmt.Sprintf("asset %s is already on this chain", assetOrigin))
+		}
+
+		assetPeg := types.NewBaseAssetPeg(nil, packet.AssetPeg.DocumentHash, packet.AssetPeg.AssetType, packet.AssetPeg.AssetQuantity, packet.AssetPeg.QuantityUnit, packet.ReceiverAddress)
+		if _, err := keeper.mintAsset(ctx, transfer.GetChainAddress(packet.SourceChainID), &assetPeg); err != nil {
+			return err
+		}
+		keeper.setAssetOrigin(ctx, assetPeg.GetPegHash(), assetOrigin)
+		return nil
+	default:
+		return ErrInvalidOrigin(keeper.codespace, fmt.Sprintf("asset issued on %s cannot be received from %s", packet.OriginChainID, packet.SourceChainID))
+	}
+}
+func (keeper Keeper) OnAcknowledgePacket(ctx sdkTypes.Context, packet transfer.Packet, success bool) sdkTypes.Error {
+	pegHash, err := keeper.getPacketPegHash(ctx, packet)
+	if err != nil {
+		return err
+	}
+	if !success {
+		return keeper.UnlockAsset(ctx, pegHash)
+	}
+	if packet.OriginChainID == packet.DestinationChainID {
+		keeper.RemoveAssetPeg(ctx, pegHash)
+		return nil
+	}
+
+	assetPeg, found := keeper.GetAssetPeg(ctx, pegHash)
+	if !found {
+		return ErrAssetNotFound(keeper.codespace, pegHash)
+	}
+	_ = assetPeg.SetOwnerAddress(transfer.GetEscrowAddress(packet.DestinationChainID))
+	keeper.SetAssetPeg(ctx, assetPeg)
+	return nil
+}
+func (keeper Keeper) OnTimeoutPacket(ctx sdkTypes.Context, packet transfer.Packet) sdkTypes.Error {
+	pegHash, err := keeper.getPacketPegHash(ctx, packet)
+	if err != nil {
+		return err
+	}
+	return keeper.UnlockAsset(ctx, pegHash)
+}
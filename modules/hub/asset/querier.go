@@ -1 +1,141 @@
 package asset
+
+import (
+	"fmt"
+
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+const (
+	QueryAsset       = "asset"
+	QueryOwnerAssets = "ownerAssets"
+	QueryAssets      = "assets"
+	QueryParams      = "params"
+
+	DefaultQueryLimit = 100
+)
+
+type QueryAssetParams struct {
+	PegHash types.PegHash `json:"pegHash"`
+}
+
+func NewQueryAssetParams(pegHash types.PegHash) QueryAssetParams {
+	return QueryAssetParams{
+		PegHash: pegHash,
+	}
+}
+
+type QueryOwnerAssetsParams struct {
+	OwnerAddress sdkTypes.AccAddress `json:"ownerAddress"`
+}
+
+func NewQueryOwnerAssetsParams(ownerAddress sdkTypes.AccAddress) QueryOwnerAssetsParams {
+	return QueryOwnerAssetsParams{
+		OwnerAddress: ownerAddress,
+	}
+}
+
+type QueryAssetsParams struct {
+	Page  int `json:"page"`
+	Limit int `json:"limit"`
+}
+
+func NewQueryAssetsParams(page int, limit int) QueryAssetsParams {
+	return QueryAssetsParams{
+		Page:  page,
+		Limit: limit,
+	}
+}
+
+func NewQuerier(keeper Keeper) sdkTypes.Querier {
+	return func(ctx sdkTypes.Context, path []string, req abciTypes.RequestQuery) ([]byte, sdkTypes.Error) {
+		switch path[0] {
+		case QueryAsset:
+			return queryAsset(ctx, req, keeper)
+		case QueryOwnerAssets:
+			return queryOwnerAssets(ctx, req, keeper)
+		case QueryAssets:
+			return queryAssets(ctx, req, keeper)
+		case QueryParams:
+			return queryParams(ctx, keeper)
+		default:
+			return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("unknown asset query endpoint: %s", path[0]))
+		}
+	}
+}
+func queryAsset(ctx sdkTypes.Context, req abciTypes.RequestQuery, keeper Keeper) ([]byte, sdkTypes.Error) {
+	var params QueryAssetParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkTypes.ErrUnknownRequest(sdkTypes.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	assetPeg, found := keeper.GetAssetPeg(ctx, params.PegHash)
+	if !found {
+		return nil, ErrAssetNotFound(keeper.codespace, params.PegHash)
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, assetPeg)
+	if err != nil {
+		return nil, sdkTypes.ErrInternal(sdkTypes.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+	return res, nil
+}
+func queryOwnerAssets(ctx sdkTypes.Context, req abciTypes.RequestQuery, keeper Keeper) ([]byte, sdkTypes.Error) {
+	var params QueryOwnerAssetsParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkTypes.ErrUnknownRequest(sdkTypes.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	assetPegs := keeper.GetAssetPegsByOwner(ctx, params.OwnerAddress)
+	if assetPegs == nil {
+		assetPegs = []types.AssetPeg{}
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, assetPegs)
+	if err != nil {
+		return nil, sdkTypes.ErrInternal(sdkTypes.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+	return res, nil
+}
+func queryAssets(ctx sdkTypes.Context, req abciTypes.RequestQuery, keeper Keeper) ([]byte, sdkTypes.Error) {
+	var params QueryAssetsParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkTypes.ErrUnknownRequest(sdkTypes.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	if params.Page < 1 {
+		params.Page = 1
+	}
+	if params.Limit < 1 || params.Limit > DefaultQueryLimit {
+		params.Limit = DefaultQueryLimit
+	}
+
+	skip := (params.Page - 1) * params.Limit
+	assetPegs := []types.AssetPeg{}
+	keeper.IterateAssetPegs(ctx, func(assetPeg types.AssetPeg) (stop bool) {
+		if skip > 0 {
+			skip--
+			return false
+		}
+		assetPegs = append(assetPegs, assetPeg)
+		return len(assetPegs) == params.Limit
+	})
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, assetPegs)
+	if err != nil {
+		return nil, sdkTypes.ErrInternal(sdkTypes.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+	return res, nil
+}
+func queryParams(ctx sdkTypes.Context, keeper Keeper) ([]byte, sdkTypes.Error) {
+	res, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetParams(ctx))
+	if err != nil {
+		return nil, sdkTypes.ErrInternal(sdkTypes.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+	return res, nil
+}
@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/commitHub/commitBlockchain/modules/hub/contract"
+)
+
+func GetQueryCommand(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	command := &cobra.Command{
+		Use:                        contract.QuerierRoute,
+		Short:                      "Querying commands for contract negotiations",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	command.AddCommand(client.GetCommands(
+		QueryNegotiationCommand(queryRoute, cdc),
+	)...)
+	return command
+}
+func QueryNegotiationCommand(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "negotiation [negotiation-id]",
+		Short: "Query a negotiation by its id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			bytes, err := cdc.MarshalJSON(contract.NewQueryNegotiationParams(args[0]))
+			if err != nil {
+				return err
+			}
+			res, _, err := cliContext.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, contract.QueryNegotiation), bytes)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+func queryNegotiation(cliContext context.CLIContext, cdc *codec.Codec, negotiationID string) (contract.Negotiation, error) {
+	bytes, err := cdc.MarshalJSON(contract.NewQueryNegotiationParams(negotiationID))
+	if err != nil {
+		return contract.Negotiation{}, err
+	}
+	res, _, err := cliContext.QueryWithData(fmt.Sprintf("custom/%s/%s", contract.QuerierRoute, contract.QueryNegotiation), bytes)
+	if err != nil {
+		return contract.Negotiation{}, err
+	}
+
+	var negotiation contract.Negotiation
+	if err := cdc.UnmarshalJSON(res, &negotiation); err != nil {
+		return contract.Negotiation{}, err
+	}
+	return negotiation, nil
+}
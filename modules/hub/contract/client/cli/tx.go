@@ -0,0 +1,181 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/commitHub/commitBlockchain/modules/hub/contract"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+func GetTxCommand(cdc *codec.Codec) *cobra.Command {
+	command := &cobra.Command{
+		Use:                        contract.RouterKey,
+		Short:                      "Contract negotiation transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	command.AddCommand(client.PostCommands(
+		ProposeTermsCommand(cdc),
+		CounterOfferCommand(cdc),
+		AcceptTermsCommand(cdc),
+		CancelNegotiationCommand(cdc),
+	)...)
+	return command
+}
+func ProposeTermsCommand(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "propose [buyer-address] [seller-address] [peg-hash] [bid] [time]",
+		Short: "Open a negotiation by proposing signed terms",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(command *cobra.Command, args []string) error {
+			transactionBuilder := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			buyerAddress, err := sdkTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			sellerAddress, err := sdkTypes.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			pegHash, err := types.GetPegHashHex(args[2])
+			if err != nil {
+				return err
+			}
+			bid, time, err := parseBidAndTime(args[3], args[4])
+			if err != nil {
+				return err
+			}
+
+			terms := contract.NewTerms(buyerAddress, sellerAddress, pegHash, bid, time)
+			signature, err := signTerms(cliContext, terms)
+			if err != nil {
+				return err
+			}
+
+			msg := contract.NewMsgProposeTerms(cliContext.GetFromAddress(), terms, signature)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliContext, transactionBuilder, []sdkTypes.Msg{msg})
+		},
+	}
+}
+func CounterOfferCommand(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "counter-offer [negotiation-id] [bid] [time]",
+		Short: "Replace the terms of an open negotiation with a signed counter offer",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(command *cobra.Command, args []string) error {
+			transactionBuilder := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			bid, time, err := parseBidAndTime(args[1], args[2])
+			if err != nil {
+				return err
+			}
+			negotiation, err := queryNegotiation(cliContext, cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			terms := contract.NewTerms(negotiation.Terms.BuyerAddress, negotiation.Terms.SellerAddress, negotiation.Terms.PegHash, bid, time)
+			signature, err := signTerms(cliContext, terms)
+			if err != nil {
+				return err
+			}
+
+			msg := contract.NewMsgCounterOffer(cliContext.GetFromAddress(), args[0], bid, time, signature)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliContext, transactionBuilder, []sdkTypes.Msg{msg})
+		},
+	}
+}
+func AcceptTermsCommand(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "accept [negotiation-id]",
+		Short: "Accept and sign the current terms of an open negotiation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			transactionBuilder := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			negotiation, err := queryNegotiation(cliContext, cdc, args[0])
+			if err != nil {
+				return err
+			}
+			signature, err := signTerms(cliContext, negotiation.Terms)
+			if err != nil {
+				return err
+			}
+
+			msg := contract.NewMsgAcceptTerms(cliContext.GetFromAddress(), args[0], signature)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliContext, transactionBuilder, []sdkTypes.Msg{msg})
+		},
+	}
+}
+func CancelNegotiationCommand(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel [negotiation-id]",
+		Short: "Cancel an open negotiation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			transactionBuilder := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			msg := contract.NewMsgCancelNegotiation(cliContext.GetFromAddress(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliContext, transactionBuilder, []sdkTypes.Msg{msg})
+		},
+	}
+}
+func parseBidAndTime(bidArg string, timeArg string) (int64, int64, error) {
+	bid, err := strconv.ParseInt(bidArg, 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	time, err := strconv.ParseInt(timeArg, 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	return bid, time, nil
+}
+func signTerms(cliContext context.CLIContext, terms contract.Terms) ([]byte, error) {
+	keybase, err := keys.NewKeyBaseFromHomeFlag()
+	if err != nil {
+		return nil, err
+	}
+	info, err := keybase.GetByAddress(cliContext.GetFromAddress())
+	if err != nil {
+		return nil, err
+	}
+	passphrase, err := keys.GetPassphrase(info.GetName())
+	if err != nil {
+		return nil, err
+	}
+	signature, _, err := keybase.Sign(info.GetName(), passphrase, terms.GetSignBytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign terms: %s", err.Error())
+	}
+	return signature, nil
+}
@@ -0,0 +1,22 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+
+	"github.com/commitHub/commitBlockchain/modules/hub/contract"
+	restTypes "github.com/commitHub/commitBlockchain/types/rest"
+)
+
+func registerQueryRoutes(cliContext context.CLIContext, router *mux.Router) {
+	router.HandleFunc("/contract/negotiations/{negotiationID}", queryNegotiationHandlerFunction(cliContext)).Methods("GET")
+}
+func queryNegotiationHandlerFunction(cliContext context.CLIContext) http.HandlerFunc {
+	return func(responseWriter http.ResponseWriter, request *http.Request) {
+		restTypes.QueryWithParams(responseWriter, request, cliContext, fmt.Sprintf("custom/%s/%s", contract.QuerierRoute, contract.QueryNegotiation), contract.NewQueryNegotiationParams(mux.Vars(request)["negotiationID"]))
+	}
+}
@@ -0,0 +1,97 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/commitHub/commitBlockchain/modules/hub/contract"
+	"github.com/commitHub/commitBlockchain/types"
+	restTypes "github.com/commitHub/commitBlockchain/types/rest"
+)
+
+type ProposeTermsRequest struct {
+	BaseRequest   rest.BaseReq        `json:"base_req"`
+	BuyerAddress  sdkTypes.AccAddress `json:"buyerAddress"`
+	SellerAddress sdkTypes.AccAddress `json:"sellerAddress"`
+	PegHash       types.PegHash       `json:"pegHash"`
+	Bid           int64               `json:"bid"`
+	Time          int64               `json:"time"`
+	Signature     []byte              `json:"signature"`
+}
+
+type CounterOfferRequest struct {
+	BaseRequest rest.BaseReq `json:"base_req"`
+	Bid         int64        `json:"bid"`
+	Time        int64        `json:"time"`
+	Signature   []byte       `json:"signature"`
+}
+
+type AcceptTermsRequest struct {
+	BaseRequest rest.BaseReq `json:"base_req"`
+	Signature   []byte       `json:"signature"`
+}
+
+type CancelNegotiationRequest struct {
+	BaseRequest rest.BaseReq `json:"base_req"`
+}
+
+func registerTxRoutes(cliContext context.CLIContext, router *mux.Router) {
+	router.HandleFunc("/contract/negotiations", proposeTermsHandlerFunction(cliContext)).Methods("POST")
+	router.HandleFunc("/contract/negotiations/{negotiationID}/counter-offer", counterOfferHandlerFunction(cliContext)).Methods("POST")
+	router.HandleFunc("/contract/negotiations/{negotiationID}/accept", acceptTermsHandlerFunction(cliContext)).Methods("POST")
+	router.HandleFunc("/contract/negotiations/{negotiationID}/cancel", cancelNegotiationHandlerFunction(cliContext)).Methods("POST")
+}
+func proposeTermsHandlerFunction(cliContext context.CLIContext) http.HandlerFunc {
+	return func(responseWriter http.ResponseWriter, request *http.Request) {
+		var proposeTermsRequest ProposeTermsRequest
+		fromAddress, ok := restTypes.ReadBaseRequest(responseWriter, request, cliContext, &proposeTermsRequest, &proposeTermsRequest.BaseRequest)
+		if !ok {
+			return
+		}
+
+		terms := contract.NewTerms(proposeTermsRequest.BuyerAddress, proposeTermsRequest.SellerAddress, proposeTermsRequest.PegHash, proposeTermsRequest.Bid, proposeTermsRequest.Time)
+		msg := contract.NewMsgProposeTerms(fromAddress, terms, proposeTermsRequest.Signature)
+		restTypes.WriteGenerateStdTxResponse(responseWriter, cliContext, proposeTermsRequest.BaseRequest, msg)
+	}
+}
+func counterOfferHandlerFunction(cliContext context.CLIContext) http.HandlerFunc {
+	return func(responseWriter http.ResponseWriter, request *http.Request) {
+		var counterOfferRequest CounterOfferRequest
+		fromAddress, ok := restTypes.ReadBaseRequest(responseWriter, request, cliContext, &counterOfferRequest, &counterOfferRequest.BaseRequest)
+		if !ok {
+			return
+		}
+
+		msg := contract.NewMsgCounterOffer(fromAddress, mux.Vars(request)["negotiationID"], counterOfferRequest.Bid, counterOfferRequest.Time, counterOfferRequest.Signature)
+		restTypes.WriteGenerateStdTxResponse(responseWriter, cliContext, counterOfferRequest.BaseRequest, msg)
+	}
+}
+func acceptTermsHandlerFunction(cliContext context.CLIContext) http.HandlerFunc {
+	return func(responseWriter http.ResponseWriter, request *http.Request) {
+		var acceptTermsRequest AcceptTermsRequest
+		fromAddress, ok := restTypes.ReadBaseRequest(responseWriter, request, cliContext, &acceptTermsRequest, &acceptTermsRequest.BaseRequest)
+		if !ok {
+			return
+		}
+
+		msg := contract.NewMsgAcceptTerms(fromAddress, mux.Vars(request)["negotiationID"], acceptTermsRequest.Signature)
+		restTypes.WriteGenerateStdTxResponse(responseWriter, cliContext, acceptTermsRequest.BaseRequest, msg)
+	}
+}
+func cancelNegotiationHandlerFunction(cliContext context.CLIContext) http.HandlerFunc {
+	return func(responseWriter http.ResponseWriter, request *http.Request) {
+		var cancelNegotiationRequest CancelNegotiationRequest
+		fromAddress, ok := restTypes.ReadBaseRequest(responseWriter, request, cliContext, &cancelNegotiationRequest, &cancelNegotiationRequest.BaseRequest)
+		if !ok {
+			return
+		}
+
+		msg := contract.NewMsgCancelNegotiation(fromAddress, mux.Vars(request)["negotiationID"])
+		restTypes.WriteGenerateStdTxResponse(responseWriter, cliContext, cancelNegotiationRequest.BaseRequest, msg)
+	}
+}
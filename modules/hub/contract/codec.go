@@ -1 +1,18 @@
-package asset
+package contract
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgProposeTerms{}, "commit/contract/MsgProposeTerms", nil)
+	cdc.RegisterConcrete(MsgCounterOffer{}, "commit/contract/MsgCounterOffer", nil)
+	cdc.RegisterConcrete(MsgAcceptTerms{}, "commit/contract/MsgAcceptTerms", nil)
+	cdc.RegisterConcrete(MsgCancelNegotiation{}, "commit/contract/MsgCancelNegotiation", nil)
+}
+
+var msgCdc = codec.New()
+
+func init() {
+	RegisterCodec(msgCdc)
+}
@@ -0,0 +1,132 @@
+package contract
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+type Status byte
+
+const (
+	StatusOpen      Status = 0x00
+	StatusAccepted  Status = 0x01
+	StatusCancelled Status = 0x02
+)
+
+func (status Status) String() string {
+	switch status {
+	case StatusOpen:
+		return "Open"
+	case StatusAccepted:
+		return "Accepted"
+	case StatusCancelled:
+		return "Cancelled"
+	default:
+		return ""
+	}
+}
+
+type Terms struct {
+	BuyerAddress  sdkTypes.AccAddress `json:"buyerAddress"`
+	SellerAddress sdkTypes.AccAddress `json:"sellerAddress"`
+	PegHash       types.PegHash       `json:"pegHash"`
+	Bid           int64               `json:"bid"`
+	Time          int64               `json:"time"`
+}
+
+func NewTerms(buyerAddress sdkTypes.AccAddress, sellerAddress sdkTypes.AccAddress, pegHash types.PegHash, bid int64, time int64) Terms {
+	return Terms{
+		BuyerAddress:  buyerAddress,
+		SellerAddress: sellerAddress,
+		PegHash:       pegHash,
+		Bid:           bid,
+		Time:          time,
+	}
+}
+func (terms Terms) ValidateBasic() sdkTypes.Error {
+	if terms.BuyerAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing buyer address")
+	}
+	if terms.SellerAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing seller address")
+	}
+	if terms.BuyerAddress.Equals(terms.SellerAddress) {
+		return sdkTypes.ErrInvalidAddress("buyer and seller cannot be the same address")
+	}
+	if terms.PegHash.Empty() {
+		return types.ErrInvalidPegHash("peg hash cannot be empty")
+	}
+	if terms.Bid <= 0 {
+		return ErrInvalidTerms(DefaultCodespace, "bid must be positive")
+	}
+	if terms.Time <= 0 {
+		return ErrInvalidTerms(DefaultCodespace, "time must be positive")
+	}
+	return nil
+}
+func (terms Terms) IsParty(address sdkTypes.AccAddress) bool {
+	return terms.BuyerAddress.Equals(address) || terms.SellerAddress.Equals(address)
+}
+func (terms Terms) Equals(other Terms) bool {
+	return terms.BuyerAddress.Equals(other.BuyerAddress) &&
+		terms.SellerAddress.Equals(other.SellerAddress) &&
+		terms.PegHash.Equals(other.PegHash) &&
+		terms.Bid == other.Bid &&
+		terms.Time == other.Time
+}
+func (terms Terms) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(terms))
+}
+func (terms Terms) String() string {
+	return fmt.Sprintf(`Terms:
+    BuyerAddress:  %s
+    SellerAddress: %s
+    PegHash:       %s
+    Bid:           %d
+    Time:          %d`,
+		terms.BuyerAddress, terms.SellerAddress, terms.PegHash, terms.Bid, terms.Time,
+	)
+}
+
+type Negotiation struct {
+	NegotiationID   string              `json:"negotiationID"`
+	Terms           Terms               `json:"terms"`
+	ProposerAddress sdkTypes.AccAddress `json:"proposerAddress"`
+	BuyerSignature  []byte              `json:"buyerSignature"`
+	SellerSignature []byte              `json:"sellerSignature"`
+	Status          Status              `json:"status"`
+}
+
+func NewNegotiation(negotiationID string, terms Terms, proposerAddress sdkTypes.AccAddress) Negotiation {
+	return Negotiation{
+		NegotiationID:   negotiationID,
+		Terms:           terms,
+		ProposerAddress: proposerAddress,
+		Status:          StatusOpen,
+	}
+}
+func (negotiation Negotiation) IsSigned() bool {
+	return len(negotiation.BuyerSignature) > 0 && len(negotiation.SellerSignature) > 0
+}
+func (negotiation *Negotiation) SetSignature(signerAddress sdkTypes.AccAddress, signature []byte) {
+	if negotiation.Terms.BuyerAddress.Equals(signerAddress) {
+		negotiation.BuyerSignature = signature
+	} else {
+		negotiation.SellerSignature = signature
+	}
+}
+func (negotiation Negotiation) String() string {
+	return fmt.Sprintf(`Negotiation:
+  NegotiationID:   %s
+  %s
+  ProposerAddress: %s
+  BuyerSignature:  %X
+  SellerSignature: %X
+  Status:          %s`,
+		negotiation.NegotiationID, negotiation.Terms, negotiation.ProposerAddress,
+		negotiation.BuyerSignature, negotiation.SellerSignature, negotiation.Status,
+	)
+}
@@ -1 +1,37 @@
-package asset
+package contract
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdkTypes.CodespaceType = "contract"
+
+	CodeNegotiationNotFound sdkTypes.CodeType = 101
+	CodeNegotiationClosed   sdkTypes.CodeType = 102
+	CodeUnauthorizedParty   sdkTypes.CodeType = 103
+	CodeInvalidSignature    sdkTypes.CodeType = 104
+	CodeInvalidTerms        sdkTypes.CodeType = 105
+	CodeOwnProposal         sdkTypes.CodeType = 106
+)
+
+func ErrNegotiationNotFound(codespace sdkTypes.CodespaceType, negotiationID string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeNegotiationNotFound, fmt.Sprintf("negotiation %s not found", negotiationID))
+}
+func ErrNegotiationClosed(codespace sdkTypes.CodespaceType, negotiationID string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeNegotiationClosed, fmt.Sprintf("negotiation %s is closed", negotiationID))
+}
+func ErrUnauthorizedParty(codespace sdkTypes.CodespaceType, address sdkTypes.AccAddress, negotiationID string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeUnauthorizedParty, fmt.Sprintf("%s is not a party to negotiation %s", address, negotiationID))
+}
+func ErrInvalidSignature(codespace sdkTypes.CodespaceType, address sdkTypes.AccAddress) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeInvalidSignature, fmt.Sprintf("signature of %s does not match the terms", address))
+}
+func ErrInvalidTerms(codespace sdkTypes.CodespaceType, msg string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeInvalidTerms, msg)
+}
+func ErrOwnProposal(codespace sdkTypes.CodespaceType, negotiationID string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeOwnProposal, fmt.Sprintf("the latest terms of negotiation %s must be accepted by the counterparty", negotiationID))
+}
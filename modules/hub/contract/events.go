@@ -0,0 +1,9 @@
+package contract
+
+var (
+	AttributeKeyNegotiationID = "negotiationID"
+	AttributeKeyBuyer         = "buyer"
+	AttributeKeySeller        = "seller"
+	AttributeKeyPegHash       = "pegHash"
+	AttributeKeyStatus        = "status"
+)
@@ -0,0 +1,16 @@
+package contract
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+type AccountKeeper interface {
+	GetAccount(ctx sdkTypes.Context, address sdkTypes.AccAddress) auth.Account
+}
+
+type AssetKeeper interface {
+	GetAssetPeg(ctx sdkTypes.Context, pegHash types.PegHash) (types.AssetPeg, bool)
+}
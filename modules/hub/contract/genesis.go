@@ -1 +1,60 @@
-package asset
+package contract
+
+import (
+	"fmt"
+	"strings"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+type GenesisState struct {
+	NegotiationCounter uint64        `json:"negotiationCounter"`
+	Negotiations       []Negotiation `json:"negotiations"`
+}
+
+func NewGenesisState(negotiationCounter uint64, negotiations []Negotiation) GenesisState {
+	return GenesisState{
+		NegotiationCounter: negotiationCounter,
+		Negotiations:       negotiations,
+	}
+}
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(0, []Negotiation{})
+}
+func InitGenesis(ctx sdkTypes.Context, keeper Keeper, genesisState GenesisState) {
+	for _, negotiation := range genesisState.Negotiations {
+		keeper.SetNegotiation(ctx, negotiation)
+	}
+	keeper.setNegotiationCounter(ctx, genesisState.NegotiationCounter)
+}
+func ExportGenesis(ctx sdkTypes.Context, keeper Keeper) GenesisState {
+	var negotiations []Negotiation
+	keeper.IterateNegotiations(ctx, func(negotiation Negotiation) (stop bool) {
+		negotiations = append(negotiations, negotiation)
+		return false
+	})
+	return NewGenesisState(keeper.getNegotiationCounter(ctx), negotiations)
+}
+func ValidateGenesis(genesisState GenesisState) error {
+	seenNegotiationIDs := make(map[string]bool)
+	for _, negotiation := range genesisState.Negotiations {
+		if len(strings.TrimSpace(negotiation.NegotiationID)) == 0 {
+			return fmt.Errorf("negotiation has an empty id")
+		}
+		if seenNegotiationIDs[negotiation.NegotiationID] {
+			return fmt.Errorf("duplicate negotiation %s", negotiation.NegotiationID)
+		}
+		seenNegotiationIDs[negotiation.NegotiationID] = true
+
+		if err := negotiation.Terms.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid terms for negotiation %s: %s", negotiation.NegotiationID, err.Error())
+		}
+		if !negotiation.Terms.IsParty(negotiation.ProposerAddress) {
+			return fmt.Errorf("proposer of negotiation %s is not a party to its terms", negotiation.NegotiationID)
+		}
+		if negotiation.Status.String() == "" {
+			return fmt.Errorf("negotiation %s has an invalid status %d", negotiation.NegotiationID, negotiation.Status)
+		}
+	}
+	return nil
+}
@@ -1 +1,87 @@
-package asset
+package contract
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewHandler(keeper Keeper) sdkTypes.Handler {
+	return func(ctx sdkTypes.Context, msg sdkTypes.Msg) sdkTypes.Result {
+		switch msg := msg.(type) {
+		case MsgProposeTerms:
+			return handleMsgProposeTerms(ctx, keeper, msg)
+		case MsgCounterOffer:
+			return handleMsgCounterOffer(ctx, keeper, msg)
+		case MsgAcceptTerms:
+			return handleMsgAcceptTerms(ctx, keeper, msg)
+		case MsgCancelNegotiation:
+			return handleMsgCancelNegotiation(ctx, keeper, msg)
+		default:
+			errMsg := fmt.Sprintf("unrecognized contract message type: %T", msg)
+			return sdkTypes.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+func handleMsgProposeTerms(ctx sdkTypes.Context, keeper Keeper, msg MsgProposeTerms) sdkTypes.Result {
+	negotiation, err := keeper.ProposeTerms(ctx, msg.ProposerAddress, msg.Terms, msg.Signature)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdkTypes.NewEvent(msg.Type(), negotiationAttributes(negotiation)...),
+	)
+	return sdkTypes.Result{
+		Data:   []byte(negotiation.NegotiationID),
+		Events: ctx.EventManager().Events(),
+	}
+}
+func handleMsgCounterOffer(ctx sdkTypes.Context, keeper Keeper, msg MsgCounterOffer) sdkTypes.Result {
+	negotiation, err := keeper.CounterOffer(ctx, msg.ProposerAddress, msg.NegotiationID, msg.Bid, msg.Time, msg.Signature)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdkTypes.NewEvent(msg.Type(), negotiationAttributes(negotiation)...),
+	)
+	return sdkTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+func handleMsgAcceptTerms(ctx sdkTypes.Context, keeper Keeper, msg MsgAcceptTerms) sdkTypes.Result {
+	negotiation, err := keeper.AcceptTerms(ctx, msg.AcceptorAddress, msg.NegotiationID, msg.Signature)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdkTypes.NewEvent(msg.Type(), negotiationAttributes(negotiation)...),
+	)
+	return sdkTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+func handleMsgCancelNegotiation(ctx sdkTypes.Context, keeper Keeper, msg MsgCancelNegotiation) sdkTypes.Result {
+	negotiation, err := keeper.CancelNegotiation(ctx, msg.CancellerAddress, msg.NegotiationID)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdkTypes.NewEvent(msg.Type(), negotiationAttributes(negotiation)...),
+	)
+	return sdkTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+func negotiationAttributes(negotiation Negotiation) []sdkTypes.Attribute {
+	return []sdkTypes.Attribute{
+		sdkTypes.NewAttribute(AttributeKeyNegotiationID, negotiation.NegotiationID),
+		sdkTypes.NewAttribute(AttributeKeyBuyer, negotiation.Terms.BuyerAddress.String()),
+		sdkTypes.NewAttribute(AttributeKeySeller, negotiation.Terms.SellerAddress.String()),
+		sdkTypes.NewAttribute(AttributeKeyPegHash, negotiation.Terms.PegHash.String()),
+		sdkTypes.NewAttribute(AttributeKeyStatus, negotiation.Status.String()),
+	}
+}
@@ -1 +1,208 @@
-package asset
+package contract
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	StoreKey     = "contract"
+	QuerierRoute = "contract"
+)
+
+var (
+	NegotiationCounterKey = []byte{0x00}
+	NegotiationKeyPrefix  = []byte{0x01}
+)
+
+func GetNegotiationKey(negotiationID string) []byte {
+	return append(NegotiationKeyPrefix, []byte(negotiationID)...)
+}
+
+type Keeper struct {
+	storeKey      sdkTypes.StoreKey
+	cdc           *codec.Codec
+	accountKeeper AccountKeeper
+	assetKeeper   AssetKeeper
+	codespace     sdkTypes.CodespaceType
+}
+
+func NewKeeper(cdc *codec.Codec, storeKey sdkTypes.StoreKey, accountKeeper AccountKeeper, assetKeeper AssetKeeper, codespace sdkTypes.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:      storeKey,
+		cdc:           cdc,
+		accountKeeper: accountKeeper,
+		assetKeeper:   assetKeeper,
+		codespace:     codespace,
+	}
+}
+func (keeper Keeper) Codespace() sdkTypes.CodespaceType {
+	return keeper.codespace
+}
+func (keeper Keeper) getNegotiationCounter(ctx sdkTypes.Context) uint64 {
+	store := ctx.KVStore(keeper.storeKey)
+	counterBytes := store.Get(NegotiationCounterKey)
+	if counterBytes == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(counterBytes)
+}
+func (keeper Keeper) setNegotiationCounter(ctx sdkTypes.Context, counter uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	counterBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(counterBytes, counter)
+	store.Set(NegotiationCounterKey, counterBytes)
+}
+func (keeper Keeper) getNextNegotiationID(ctx sdkTypes.Context, terms Terms) string {
+	counter := keeper.getNegotiationCounter(ctx) + 1
+	keeper.setNegotiationCounter(ctx, counter)
+
+	counterBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(counterBytes, counter)
+	return fmt.Sprintf("%X", tmhash.SumTruncated(append(counterBytes, terms.GetSignBytes()...)))
+}
+func (keeper Keeper) GetNegotiation(ctx sdkTypes.Context, negotiationID string) (Negotiation, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	negotiationBytes := store.Get(GetNegotiationKey(negotiationID))
+	if negotiationBytes == nil {
+		return Negotiation{}, false
+	}
+
+	var negotiation Negotiation
+	keeper.cdc.MustUnmarshalBinaryBare(negotiationBytes, &negotiation)
+	return negotiation, true
+}
+func (keeper Keeper) SetNegotiation(ctx sdkTypes.Context, negotiation Negotiation) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(GetNegotiationKey(negotiation.NegotiationID), keeper.cdc.MustMarshalBinaryBare(negotiation))
+}
+func (keeper Keeper) IterateNegotiations(ctx sdkTypes.Context, handler func(negotiation Negotiation) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdkTypes.KVStorePrefixIterator(store, NegotiationKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var negotiation Negotiation
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &negotiation)
+		if handler(negotiation) {
+			break
+		}
+	}
+}
+func (keeper Keeper) GetAcceptedTerms(ctx sdkTypes.Context, negotiationID string) (Terms, bool) {
+	negotiation, found := keeper.GetNegotiation(ctx, negotiationID)
+	if !found || negotiation.Status != StatusAccepted {
+		return Terms{}, false
+	}
+	return negotiation.Terms, true
+}
+func (keeper Keeper) verifySignature(ctx sdkTypes.Context, signerAddress sdkTypes.AccAddress, terms Terms, signature []byte) sdkTypes.Error {
+	account := keeper.accountKeeper.GetAccount(ctx, signerAddress)
+	if account == nil || account.GetPubKey() == nil {
+		return sdkTypes.ErrInvalidPubKey(fmt.Sprintf("no public key found for %s", signerAddress))
+	}
+	if !account.GetPubKey().VerifyBytes(terms.GetSignBytes(), signature) {
+		return ErrInvalidSignature(keeper.codespace, signerAddress)
+	}
+	return nil
+}
+func (keeper Keeper) validateTerms(ctx sdkTypes.Context, terms Terms) sdkTypes.Error {
+	if terms.Time <= ctx.BlockHeight() {
+		return ErrInvalidTerms(keeper.codespace, fmt.Sprintf("time %d has already passed", terms.Time))
+	}
+
+	assetPeg, found := keeper.assetKeeper.GetAssetPeg(ctx, terms.PegHash)
+	if !found {
+		return ErrInvalidTerms(keeper.codespace, fmt.Sprintf("asset peg %s not found", terms.PegHash))
+	}
+	if !assetPeg.GetOwnerAddress().Equals(terms.SellerAddress) {
+		return ErrInvalidTerms(keeper.codespace, fmt.Sprintf("asset peg %s is not owned by the seller", terms.PegHash))
+	}
+	return nil
+}
+func (keeper Keeper) getOpenNegotiation(ctx sdkTypes.Context, partyAddress sdkTypes.AccAddress, negotiationID string) (Negotiation, sdkTypes.Error) {
+	negotiation, found := keeper.GetNegotiation(ctx, negotiationID)
+	if !found {
+		return Negotiation{}, ErrNegotiationNotFound(keeper.codespace, negotiationID)
+	}
+	if negotiation.Status != StatusOpen {
+		return Negotiation{}, ErrNegotiationClosed(keeper.codespace, negotiationID)
+	}
+	if !negotiation.Terms.IsParty(partyAddress) {
+		return Negotiation{}, ErrUnauthorizedParty(keeper.codespace, partyAddress, negotiationID)
+	}
+	return negotiation, nil
+}
+func (keeper Keeper) ProposeTerms(ctx sdkTypes.Context, proposerAddress sdkTypes.AccAddress, terms Terms, signature []byte) (Negotiation, sdkTypes.Error) {
+	if !terms.IsParty(proposerAddress) {
+		return Negotiation{}, ErrInvalidTerms(keeper.codespace, fmt.Sprintf("proposer %s is neither the buyer nor the seller", proposerAddress))
+	}
+	if err := keeper.validateTerms(ctx, terms); err != nil {
+		return Negotiation{}, err
+	}
+	if err := keeper.verifySignature(ctx, proposerAddress, terms, signature); err != nil {
+		return Negotiation{}, err
+	}
+
+	negotiation := NewNegotiation(keeper.getNextNegotiationID(ctx, terms), terms, proposerAddress)
+	negotiation.SetSignature(proposerAddress, signature)
+	keeper.SetNegotiation(ctx, negotiation)
+	return negotiation, nil
+}
+func (keeper Keeper) CounterOffer(ctx sdkTypes.Context, proposerAddress sdkTypes.AccAddress, negotiationID string, bid int64, time int64, signature []byte) (Negotiation, sdkTypes.Error) {
+	negotiation, err := keeper.getOpenNegotiation(ctx, proposerAddress, negotiationID)
+	if err != nil {
+		return Negotiation{}, err
+	}
+
+	terms := NewTerms(negotiation.Terms.BuyerAddress, negotiation.Terms.SellerAddress, negotiation.Terms.PegHash, bid, time)
+	if err := keeper.validateTerms(ctx, terms); err != nil {
+		return Negotiation{}, err
+	}
+	if err := keeper.verifySignature(ctx, proposerAddress, terms, signature); err != nil {
+		return Negotiation{}, err
+	}
+
+	negotiation.Terms = terms
+	negotiation.ProposerAddress = proposerAddress
+	negotiation.BuyerSignature = nil
+	negotiation.SellerSignature = nil
+	negotiation.SetSignature(proposerAddress, signature)
+	keeper.SetNegotiation(ctx, negotiation)
+	return negotiation, nil
+}
+func (keeper Keeper) AcceptTerms(ctx sdkTypes.Context, acceptorAddress sdkTypes.AccAddress, negotiationID string, signature []byte) (Negotiation, sdkTypes.Error) {
+	negotiation, err := keeper.getOpenNegotiation(ctx, acceptorAddress, negotiationID)
+	if err != nil {
+		return Negotiation{}, err
+	}
+	if negotiation.ProposerAddress.Equals(acceptorAddress) {
+		return Negotiation{}, ErrOwnProposal(keeper.codespace, negotiationID)
+	}
+	if err := keeper.validateTerms(ctx, negotiation.Terms); err != nil {
+		return Negotiation{}, err
+	}
+	if err := keeper.verifySignature(ctx, acceptorAddress, negotiation.Terms, signature); err != nil {
+		return Negotiation{}, err
+	}
+
+	negotiation.SetSignature(acceptorAddress, signature)
+	negotiation.Status = StatusAccepted
+	keeper.SetNegotiation(ctx, negotiation)
+	return negotiation, nil
+}
+func (keeper Keeper) CancelNegotiation(ctx sdkTypes.Context, cancellerAddress sdkTypes.AccAddress, negotiationID string) (Negotiation, sdkTypes.Error) {
+	negotiation, err := keeper.getOpenNegotiation(ctx, cancellerAddress, negotiationID)
+	if err != nil {
+		return Negotiation{}, err
+	}
+
+	negotiation.Status = StatusCancelled
+	keeper.SetNegotiation(ctx, negotiation)
+	return negotiation, nil
+}
@@ -0,0 +1,114 @@
+package contract
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+type testAccountKeeper struct {
+	accounts map[string]auth.Account
+}
+
+func (accountKeeper testAccountKeeper) GetAccount(ctx sdkTypes.Context, address sdkTypes.AccAddress) auth.Account {
+	return accountKeeper.accounts[address.String()]
+}
+
+type testAssetKeeper struct {
+	assetPegs map[string]types.AssetPeg
+}
+
+func (assetKeeper testAssetKeeper) GetAssetPeg(ctx sdkTypes.Context, pegHash types.PegHash) (types.AssetPeg, bool) {
+	assetPeg, found := assetKeeper.assetPegs[pegHash.String()]
+	return assetPeg, found
+}
+
+func newTestContext(t *testing.T, storeKey sdkTypes.StoreKey, height int64) sdkTypes.Context {
+	multiStore := store.NewCommitMultiStore(dbm.NewMemDB())
+	multiStore.MountStoreWithDB(storeKey, sdkTypes.StoreTypeIAVL, nil)
+	if err := multiStore.LoadLatestVersion(); err != nil {
+		t.Fatal(err)
+	}
+	return sdkTypes.NewContext(multiStore, abci.Header{Height: height}, false, log.NewNopLogger())
+}
+
+func newTestAccount(t *testing.T, accountKeeper testAccountKeeper) (crypto.PrivKey, sdkTypes.AccAddress) {
+	privKey := secp256k1.GenPrivKey()
+	address := sdkTypes.AccAddress(privKey.PubKey().Address())
+	account := auth.NewBaseAccountWithAddress(address)
+	if err := account.SetPubKey(privKey.PubKey()); err != nil {
+		t.Fatal(err)
+	}
+	accountKeeper.accounts[address.String()] = &account
+	return privKey, address
+}
+
+func sign(t *testing.T, privKey crypto.PrivKey, terms Terms) []byte {
+	signature, err := privKey.Sign(terms.GetSignBytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signature
+}
+
+func TestNonPartyCannotSignContract(t *testing.T) {
+	storeKey := sdkTypes.NewKVStoreKey(StoreKey)
+	accountKeeper := testAccountKeeper{accounts: make(map[string]auth.Account)}
+	assetKeeper := testAssetKeeper{assetPegs: make(map[string]types.AssetPeg)}
+	keeper := NewKeeper(codec.New(), storeKey, accountKeeper, assetKeeper, DefaultCodespace)
+	ctx := newTestContext(t, storeKey, 5)
+
+	buyerKey, buyerAddress := newTestAccount(t, accountKeeper)
+	sellerKey, sellerAddress := newTestAccount(t, accountKeeper)
+	outsiderKey, outsiderAddress := newTestAccount(t, accountKeeper)
+
+	assetPeg := types.NewBaseAssetPeg(types.PegHash("peg"), "documentHash", "sugar", 10, "tonne", sellerAddress)
+	assetKeeper.assetPegs[assetPeg.PegHash.String()] = &assetPeg
+	terms := NewTerms(buyerAddress, sellerAddress, assetPeg.PegHash, 100, 50)
+
+	if _, err := keeper.ProposeTerms(ctx, outsiderAddress, terms, sign(t, outsiderKey, terms)); err == nil || err.Code() != CodeInvalidTerms {
+		t.Fatalf("expected a proposal from a non-party to fail with %d, got %v", CodeInvalidTerms, err)
+	}
+	if _, err := keeper.ProposeTerms(ctx, buyerAddress, terms, sign(t, outsiderKey, terms)); err == nil || err.Code() != CodeInvalidSignature {
+		t.Fatalf("expected a proposal signed by a non-party to fail with %d, got %v", CodeInvalidSignature, err)
+	}
+
+	negotiation, err := keeper.ProposeTerms(ctx, buyerAddress, terms, sign(t, buyerKey, terms))
+	if err != nil {
+		t.Fatalf("expected the buyer's proposal to succeed, got %s", err.Error())
+	}
+
+	if _, err := keeper.AcceptTerms(ctx, outsiderAddress, negotiation.NegotiationID, sign(t, outsiderKey, terms)); err == nil || err.Code() != CodeUnauthorizedParty {
+		t.Fatalf("expected acceptance by a non-party to fail with %d, got %v", CodeUnauthorizedParty, err)
+	}
+	if _, err := keeper.AcceptTerms(ctx, sellerAddress, negotiation.NegotiationID, sign(t, outsiderKey, terms)); err == nil || err.Code() != CodeInvalidSignature {
+		t.Fatalf("expected acceptance signed by a non-party to fail with %d, got %v", CodeInvalidSignature, err)
+	}
+	counterTerms := NewTerms(buyerAddress, sellerAddress, assetPeg.PegHash, 200, 50)
+	if _, err := keeper.CounterOffer(ctx, outsiderAddress, negotiation.NegotiationID, 200, 50, sign(t, outsiderKey, counterTerms)); err == nil || err.Code() != CodeUnauthorizedParty {
+		t.Fatalf("expected a counter offer by a non-party to fail with %d, got %v", CodeUnauthorizedParty, err)
+	}
+	if _, err := keeper.CancelNegotiation(ctx, outsiderAddress, negotiation.NegotiationID); err == nil || err.Code() != CodeUnauthorizedParty {
+		t.Fatalf("expected cancellation by a non-party to fail with %d, got %v", CodeUnauthorizedParty, err)
+	}
+
+	if stored, _ := keeper.GetNegotiation(ctx, negotiation.NegotiationID); stored.Status != StatusOpen || stored.IsSigned() {
+		t.Fatalf("expected the rejected signatures to leave the negotiation open and half signed, got %v", stored)
+	}
+	if _, err := keeper.AcceptTerms(ctx, sellerAddress, negotiation.NegotiationID, sign(t, sellerKey, terms)); err != nil {
+		t.Fatalf("expected the seller's acceptance to succeed, got %s", err.Error())
+	}
+	if stored, _ := keeper.GetNegotiation(ctx, negotiation.NegotiationID); stored.Status != StatusAccepted || !stored.IsSigned() {
+		t.Fatalf("expected the negotiation to be accepted and signed by both parties, got %v", stored)
+	}
+}
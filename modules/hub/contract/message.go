@@ -1 +1,161 @@
-package asset
+package contract
+
+import (
+	"strings"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+const RouterKey = "contract"
+
+type MsgProposeTerms struct {
+	ProposerAddress sdkTypes.AccAddress `json:"proposerAddress"`
+	Terms           Terms               `json:"terms"`
+	Signature       []byte              `json:"signature"`
+}
+
+var _ sdkTypes.Msg = MsgProposeTerms{}
+
+func NewMsgProposeTerms(proposerAddress sdkTypes.AccAddress, terms Terms, signature []byte) MsgProposeTerms {
+	return MsgProposeTerms{
+		ProposerAddress: proposerAddress,
+		Terms:           terms,
+		Signature:       signature,
+	}
+}
+func (msg MsgProposeTerms) Route() string { return RouterKey }
+func (msg MsgProposeTerms) Type() string  { return "proposeTerms" }
+func (msg MsgProposeTerms) ValidateBasic() sdkTypes.Error {
+	if msg.ProposerAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing proposer address")
+	}
+	if err := msg.Terms.ValidateBasic(); err != nil {
+		return err
+	}
+	if !msg.Terms.IsParty(msg.ProposerAddress) {
+		return sdkTypes.ErrInvalidAddress("proposer must be the buyer or the seller")
+	}
+	if len(msg.Signature) == 0 {
+		return ErrInvalidSignature(DefaultCodespace, msg.ProposerAddress)
+	}
+	return nil
+}
+func (msg MsgProposeTerms) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+func (msg MsgProposeTerms) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.ProposerAddress}
+}
+
+type MsgCounterOffer struct {
+	ProposerAddress sdkTypes.AccAddress `json:"proposerAddress"`
+	NegotiationID   string              `json:"negotiationID"`
+	Bid             int64               `json:"bid"`
+	Time            int64               `json:"time"`
+	Signature       []byte              `json:"signature"`
+}
+
+var _ sdkTypes.Msg = MsgCounterOffer{}
+
+func NewMsgCounterOffer(proposerAddress sdkTypes.AccAddress, negotiationID string, bid int64, time int64, signature []byte) MsgCounterOffer {
+	return MsgCounterOffer{
+		ProposerAddress: proposerAddress,
+		NegotiationID:   negotiationID,
+		Bid:             bid,
+		Time:            time,
+		Signature:       signature,
+	}
+}
+func (msg MsgCounterOffer) Route() string { return RouterKey }
+func (msg MsgCounterOffer) Type() string  { return "counterOffer" }
+func (msg MsgCounterOffer) ValidateBasic() sdkTypes.Error {
+	if msg.ProposerAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing proposer address")
+	}
+	if len(strings.TrimSpace(msg.NegotiationID)) == 0 {
+		return ErrInvalidTerms(DefaultCodespace, "negotiation id cannot be empty")
+	}
+	if msg.Bid <= 0 {
+		return ErrInvalidTerms(DefaultCodespace, "bid must be positive")
+	}
+	if msg.Time <= 0 {
+		return ErrInvalidTerms(DefaultCodespace, "time must be positive")
+	}
+	if len(msg.Signature) == 0 {
+		return ErrInvalidSignature(DefaultCodespace, msg.ProposerAddress)
+	}
+	return nil
+}
+func (msg MsgCounterOffer) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+func (msg MsgCounterOffer) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.ProposerAddress}
+}
+
+type MsgAcceptTerms struct {
+	AcceptorAddress sdkTypes.AccAddress `json:"acceptorAddress"`
+	NegotiationID   string              `json:"negotiationID"`
+	Signature       []byte              `json:"signature"`
+}
+
+var _ sdkTypes.Msg = MsgAcceptTerms{}
+
+func NewMsgAcceptTerms(acceptorAddress sdkTypes.AccAddress, negotiationID string, signature []byte) MsgAcceptTerms {
+	return MsgAcceptTerms{
+		AcceptorAddress: acceptorAddress,
+		NegotiationID:   negotiationID,
+		Signature:       signature,
+	}
+}
+func (msg MsgAcceptTerms) Route() string { return RouterKey }
+func (msg MsgAcceptTerms) Type() string  { return "acceptTerms" }
+func (msg MsgAcceptTerms) ValidateBasic() sdkTypes.Error {
+	if msg.AcceptorAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing acceptor address")
+	}
+	if len(strings.TrimSpace(msg.NegotiationID)) == 0 {
+		return ErrInvalidTerms(DefaultCodespace, "negotiation id cannot be empty")
+	}
+	if len(msg.Signature) == 0 {
+		return ErrInvalidSignature(DefaultCodespace, msg.AcceptorAddress)
+	}
+	return nil
+}
+func (msg MsgAcceptTerms) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+func (msg MsgAcceptTerms) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.AcceptorAddress}
+}
+
+type MsgCancelNegotiation struct {
+	CancellerAddress sdkTypes.AccAddress `json:"cancellerAddress"`
+	NegotiationID    string              `json:"negotiationID"`
+}
+
+var _ sdkTypes.Msg = MsgCancelNegotiation{}
+
+func NewMsgCancelNegotiation(cancellerAddress sdkTypes.AccAddress, negotiationID string) MsgCancelNegotiation {
+	return MsgCancelNegotiation{
+		CancellerAddress: cancellerAddress,
+		NegotiationID:    negotiationID,
+	}
+}
+func (msg MsgCancelNegotiation) Route() string { return RouterKey }
+func (msg MsgCancelNegotiation) Type() string  { return "cancelNegotiation" }
+func (msg MsgCancelNegotiation) ValidateBasic() sdkTypes.Error {
+	if msg.CancellerAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing canceller address")
+	}
+	if len(strings.TrimSpace(msg.NegotiationID)) == 0 {
+		return ErrInvalidTerms(DefaultCodespace, "negotiation id cannot be empty")
+	}
+	return nil
+}
+func (msg MsgCancelNegotiation) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+func (msg MsgCancelNegotiation) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.CancellerAddress}
+}
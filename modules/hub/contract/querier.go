@@ -1 +1,52 @@
-package asset
+package contract
+
+import (
+	"fmt"
+
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	QueryNegotiation = "negotiation"
+)
+
+type QueryNegotiationParams struct {
+	NegotiationID string `json:"negotiationID"`
+}
+
+func NewQueryNegotiationParams(negotiationID string) QueryNegotiationParams {
+	return QueryNegotiationParams{
+		NegotiationID: negotiationID,
+	}
+}
+
+func NewQuerier(keeper Keeper) sdkTypes.Querier {
+	return func(ctx sdkTypes.Context, path []string, req abciTypes.RequestQuery) ([]byte, sdkTypes.Error) {
+		switch path[0] {
+		case QueryNegotiation:
+			return queryNegotiation(ctx, req, keeper)
+		default:
+			return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("unknown contract query endpoint: %s", path[0]))
+		}
+	}
+}
+func queryNegotiation(ctx sdkTypes.Context, req abciTypes.RequestQuery, keeper Keeper) ([]byte, sdkTypes.Error) {
+	var params QueryNegotiationParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkTypes.ErrUnknownRequest(sdkTypes.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	negotiation, found := keeper.GetNegotiation(ctx, params.NegotiationID)
+	if !found {
+		return nil, ErrNegotiationNotFound(keeper.codespace, params.NegotiationID)
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, negotiation)
+	if err != nil {
+		return nil, sdkTypes.ErrInternal(sdkTypes.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+	return res, nil
+}
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/commitHub/commitBlockchain/modules/hub/escrow"
+)
+
+func GetQueryCommand(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	command := &cobra.Command{
+		Use:                        escrow.QuerierRoute,
+		Short:                      "Querying commands for escrows",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	command.AddCommand(client.GetCommands(
+		QueryEscrowCommand(queryRoute, cdc),
+	)...)
+	return command
+}
+func QueryEscrowCommand(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "escrow [order-id]",
+		Short: "Query the escrow of an order",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			bytes, err := cdc.MarshalJSON(escrow.NewQueryEscrowParams(args[0]))
+			if err != nil {
+				return err
+			}
+			res, _, err := cliContext.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, escrow.QueryEscrow), bytes)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
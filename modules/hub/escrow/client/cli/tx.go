@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/commitHub/commitBlockchain/modules/hub/escrow"
+)
+
+func GetTxCommand(cdc *codec.Codec) *cobra.Command {
+	command := &cobra.Command{
+		Use:                        escrow.RouterKey,
+		Short:                      "Escrow transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	command.AddCommand(client.PostCommands(
+		LockFiatCommand(cdc),
+		LockAssetCommand(cdc),
+		RefundEscrowCommand(cdc),
+	)...)
+	return command
+}
+func LockFiatCommand(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "lock-fiat [order-id]",
+		Short: "Lock the buyer's fiat into the escrow of an accepted contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			transactionBuilder := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			msg := escrow.NewMsgLockFiat(args[0], cliContext.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliContext, transactionBuilder, []sdkTypes.Msg{msg})
+		},
+	}
+}
+func LockAssetCommand(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "lock-asset [order-id]",
+		Short: "Lock the seller's asset into the escrow of an accepted contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			transactionBuilder := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			msg := escrow.NewMsgLockAsset(args[0], cliContext.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliContext, transactionBuilder, []sdkTypes.Msg{msg})
+		},
+	}
+}
+func RefundEscrowCommand(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "refund [order-id]",
+		Short: "Refund both legs of an expired open escrow to the buyer and seller",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			transactionBuilder := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			msg := escrow.NewMsgRefundEscrow(cliContext.GetFromAddress(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliContext, transactionBuilder, []sdkTypes.Msg{msg})
+		},
+	}
+}
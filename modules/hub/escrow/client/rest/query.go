@@ -0,0 +1,22 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+
+	"github.com/commitHub/commitBlockchain/modules/hub/escrow"
+	restTypes "github.com/commitHub/commitBlockchain/types/rest"
+)
+
+func registerQueryRoutes(cliContext context.CLIContext, router *mux.Router) {
+	router.HandleFunc("/escrow/escrows/{orderID}", queryEscrowHandlerFunction(cliContext)).Methods("GET")
+}
+func queryEscrowHandlerFunction(cliContext context.CLIContext) http.HandlerFunc {
+	return func(responseWriter http.ResponseWriter, request *http.Request) {
+		restTypes.QueryWithParams(responseWriter, request, cliContext, fmt.Sprintf("custom/%s/%s", escrow.QuerierRoute, escrow.QueryEscrow), escrow.NewQueryEscrowParams(mux.Vars(request)["orderID"]))
+	}
+}
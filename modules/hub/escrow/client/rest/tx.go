@@ -0,0 +1,67 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/commitHub/commitBlockchain/modules/hub/escrow"
+	restTypes "github.com/commitHub/commitBlockchain/types/rest"
+)
+
+type LockFiatRequest struct {
+	BaseRequest rest.BaseReq `json:"base_req"`
+}
+
+type LockAssetRequest struct {
+	BaseRequest rest.BaseReq `json:"base_req"`
+}
+
+type RefundEscrowRequest struct {
+	BaseRequest rest.BaseReq `json:"base_req"`
+}
+
+func registerTxRoutes(cliContext context.CLIContext, router *mux.Router) {
+	router.HandleFunc("/escrow/escrows/{orderID}/lock-fiat", lockFiatHandlerFunction(cliContext)).Methods("POST")
+	router.HandleFunc("/escrow/escrows/{orderID}/lock-asset", lockAssetHandlerFunction(cliContext)).Methods("POST")
+	router.HandleFunc("/escrow/escrows/{orderID}/refund", refundEscrowHandlerFunction(cliContext)).Methods("POST")
+}
+func lockFiatHandlerFunction(cliContext context.CLIContext) http.HandlerFunc {
+	return func(responseWriter http.ResponseWriter, request *http.Request) {
+		var lockFiatRequest LockFiatRequest
+		fromAddress, ok := restTypes.ReadBaseRequest(responseWriter, request, cliContext, &lockFiatRequest, &lockFiatRequest.BaseRequest)
+		if !ok {
+			return
+		}
+
+		msg := escrow.NewMsgLockFiat(mux.Vars(request)["orderID"], fromAddress)
+		restTypes.WriteGenerateStdTxResponse(responseWriter, cliContext, lockFiatRequest.BaseRequest, msg)
+	}
+}
+func lockAssetHandlerFunction(cliContext context.CLIContext) http.HandlerFunc {
+	return func(responseWriter http.ResponseWriter, request *http.Request) {
+		var lockAssetRequest LockAssetRequest
+		fromAddress, ok := restTypes.ReadBaseRequest(responseWriter, request, cliContext, &lockAssetRequest, &lockAssetRequest.BaseRequest)
+		if !ok {
+			return
+		}
+
+		msg := escrow.NewMsgLockAsset(mux.Vars(request)["orderID"], fromAddress)
+		restTypes.WriteGenerateStdTxResponse(responseWriter, cliContext, lockAssetRequest.BaseRequest, msg)
+	}
+}
+func refundEscrowHandlerFunction(cliContext context.CLIContext) http.HandlerFunc {
+	return func(responseWriter http.ResponseWriter, request *http.Request) {
+		var refundEscrowRequest RefundEscrowRequest
+		fromAddress, ok := restTypes.ReadBaseRequest(responseWriter, request, cliContext, &refundEscrowRequest, &refundEscrowRequest.BaseRequest)
+		if !ok {
+			return
+		}
+
+		msg := escrow.NewMsgRefundEscrow(fromAddress, mux.Vars(request)["orderID"])
+		restTypes.WriteGenerateStdTxResponse(responseWriter, cliContext, refundEscrowRequest.BaseRequest, msg)
+	}
+}
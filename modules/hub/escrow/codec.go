@@ -1 +1,17 @@
-package asset
+package escrow
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgLockFiat{}, "commit/escrow/MsgLockFiat", nil)
+	cdc.RegisterConcrete(MsgLockAsset{}, "commit/escrow/MsgLockAsset", nil)
+	cdc.RegisterConcrete(MsgRefundEscrow{}, "commit/escrow/MsgRefundEscrow", nil)
+}
+
+var msgCdc = codec.New()
+
+func init() {
+	RegisterCodec(msgCdc)
+}
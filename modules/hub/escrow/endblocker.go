@@ -0,0 +1,48 @@
+package escrow
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+const MaxExpiredEscrowsPerBlock = 100
+
+func EndBlocker(ctx sdkTypes.Context, keeper Keeper) {
+	logger := ctx.Logger().With("module", "x/escrow")
+
+	var queueKeys [][]byte
+	var expiredOrderIDs []string
+	iterator := keeper.ExpiredEscrowQueueIterator(ctx, ctx.BlockHeight())
+	for ; iterator.Valid() && len(expiredOrderIDs) < MaxExpiredEscrowsPerBlock; iterator.Next() {
+		queueKeys = append(queueKeys, append([]byte(nil), iterator.Key()...))
+		expiredOrderIDs = append(expiredOrderIDs, string(iterator.Value()))
+	}
+	iterator.Close()
+
+	for i, orderID := range expiredOrderIDs {
+		if escrow, found := keeper.GetEscrow(ctx, orderID); !found || escrow.Status != StatusOpen {
+			keeper.removeEscrowQueueKey(ctx, queueKeys[i])
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		escrow, err := keeper.RefundEscrow(cacheCtx, orderID)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to refund escrow for order %s, retrying next block: %s", orderID, err.Error()))
+			ctx.EventManager().EmitEvent(
+				sdkTypes.NewEvent(EventTypeEscrowRefundFailed,
+					sdkTypes.NewAttribute(AttributeKeyOrderID, orderID),
+					sdkTypes.NewAttribute(AttributeKeyError, err.Error()),
+				),
+			)
+			continue
+		}
+		writeCache()
+
+		ctx.EventManager().EmitEvent(
+			sdkTypes.NewEvent(EventTypeEscrowRefunded, escrowAttributes(escrow)...),
+		)
+		logger.Info(fmt.Sprintf("refunded expired escrow for order %s", orderID))
+	}
+}
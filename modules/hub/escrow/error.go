@@ -1 +1,53 @@
-package asset
+package escrow
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdkTypes.CodespaceType = "escrow"
+
+	CodeEscrowNotFound      sdkTypes.CodeType = 101
+	CodeTermsMismatch       sdkTypes.CodeType = 102
+	CodeLegAlreadyLocked    sdkTypes.CodeType = 103
+	CodeEscrowClosed        sdkTypes.CodeType = 104
+	CodeInvalidDeadline     sdkTypes.CodeType = 105
+	CodeInvalidOrderID      sdkTypes.CodeType = 106
+	CodeContractNotAccepted sdkTypes.CodeType = 108
+	CodeEscrowNotExpired    sdkTypes.CodeType = 109
+	CodeContractNotFound    sdkTypes.CodeType = 110
+	CodeUnauthorizedParty   sdkTypes.CodeType = 111
+)
+
+func ErrEscrowNotFound(codespace sdkTypes.CodespaceType, orderID string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeEscrowNotFound, fmt.Sprintf("escrow for order %s not found", orderID))
+}
+func ErrTermsMismatch(codespace sdkTypes.CodespaceType, orderID string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeTermsMismatch, fmt.Sprintf("terms do not match the escrow for order %s", orderID))
+}
+func ErrLegAlreadyLocked(codespace sdkTypes.CodespaceType, orderID string, leg string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeLegAlreadyLocked, fmt.Sprintf("%s leg of order %s is already locked", leg, orderID))
+}
+func ErrEscrowClosed(codespace sdkTypes.CodespaceType, orderID string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeEscrowClosed, fmt.Sprintf("escrow for order %s is closed", orderID))
+}
+func ErrInvalidDeadline(codespace sdkTypes.CodespaceType, deadline int64) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeInvalidDeadline, fmt.Sprintf("deadline %d has already passed", deadline))
+}
+func ErrInvalidOrderID(codespace sdkTypes.CodespaceType) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeInvalidOrderID, "order id cannot be empty")
+}
+func ErrContractNotAccepted(codespace sdkTypes.CodespaceType, orderID string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeContractNotAccepted, fmt.Sprintf("contract for order %s has not been accepted", orderID))
+}
+func ErrEscrowNotExpired(codespace sdkTypes.CodespaceType, orderID string, deadline int64) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeEscrowNotExpired, fmt.Sprintf("escrow for order %s does not expire until height %d", orderID, deadline))
+}
+func ErrContractNotFound(codespace sdkTypes.CodespaceType, orderID string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeContractNotFound, fmt.Sprintf("contract for order %s not found", orderID))
+}
+func ErrUnauthorizedParty(codespace sdkTypes.CodespaceType, address sdkTypes.AccAddress, leg string, orderID string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeUnauthorizedParty, fmt.Sprintf("%s cannot lock the %s leg of order %s", address, leg, orderID))
+}
@@ -0,0 +1,76 @@
+package escrow
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+type Status byte
+
+const (
+	StatusOpen     Status = 0x00
+	StatusSettled  Status = 0x01
+	StatusRefunded Status = 0x02
+)
+
+func (status Status) String() string {
+	switch status {
+	case StatusOpen:
+		return "Open"
+	case StatusSettled:
+		return "Settled"
+	case StatusRefunded:
+		return "Refunded"
+	default:
+		return ""
+	}
+}
+
+type Escrow struct {
+	OrderID       string              `json:"orderID"`
+	BuyerAddress  sdkTypes.AccAddress `json:"buyerAddress"`
+	SellerAddress sdkTypes.AccAddress `json:"sellerAddress"`
+	PegHash       types.PegHash       `json:"pegHash"`
+	FiatAmount    int64               `json:"fiatAmount"`
+	Deadline      int64               `json:"deadline"`
+	FiatLocked    bool                `json:"fiatLocked"`
+	AssetLocked   bool                `json:"assetLocked"`
+	Status        Status              `json:"status"`
+}
+
+func NewEscrow(orderID string, buyerAddress sdkTypes.AccAddress, sellerAddress sdkTypes.AccAddress, pegHash types.PegHash, fiatAmount int64, deadline int64) Escrow {
+	return Escrow{
+		OrderID:       orderID,
+		BuyerAddress:  buyerAddress,
+		SellerAddress: sellerAddress,
+		PegHash:       pegHash,
+		FiatAmount:    fiatAmount,
+		Deadline:      deadline,
+		Status:        StatusOpen,
+	}
+}
+func (escrow Escrow) MatchesTerms(buyerAddress sdkTypes.AccAddress, sellerAddress sdkTypes.AccAddress, pegHash types.PegHash, fiatAmount int64, deadline int64) bool {
+	return escrow.BuyerAddress.Equals(buyerAddress) &&
+		escrow.SellerAddress.Equals(sellerAddress) &&
+		escrow.PegHash.Equals(pegHash) &&
+		escrow.FiatAmount == fiatAmount &&
+		escrow.Deadline == deadline
+}
+func (escrow Escrow) String() string {
+	return fmt.Sprintf(`Escrow:
+  OrderID:       %s
+  BuyerAddress:  %s
+  SellerAddress: %s
+  PegHash:       %s
+  FiatAmount:    %d
+  Deadline:      %d
+  FiatLocked:    %t
+  AssetLocked:   %t
+  Status:        %s`,
+		escrow.OrderID, escrow.BuyerAddress, escrow.SellerAddress, escrow.PegHash, escrow.FiatAmount,
+		escrow.Deadline, escrow.FiatLocked, escrow.AssetLocked, escrow.Status,
+	)
+}
@@ -0,0 +1,13 @@
+package escrow
+
+var (
+	EventTypeEscrowRefunded     = "escrowRefunded"
+	EventTypeEscrowRefundFailed = "escrowRefundFailed"
+
+	AttributeKeyOrderID = "orderID"
+	AttributeKeyBuyer   = "buyer"
+	AttributeKeySeller  = "seller"
+	AttributeKeyPegHash = "pegHash"
+	AttributeKeyStatus  = "status"
+	AttributeKeyError   = "error"
+)
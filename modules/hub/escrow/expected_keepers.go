@@ -0,0 +1,22 @@
+package escrow
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/hub/contract"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+type AssetKeeper interface {
+	LockAsset(ctx sdkTypes.Context, ownerAddress sdkTypes.AccAddress, pegHash types.PegHash) sdkTypes.Error
+	UnlockAsset(ctx sdkTypes.Context, pegHash types.PegHash) sdkTypes.Error
+	SendAsset(ctx sdkTypes.Context, fromAddress sdkTypes.AccAddress, toAddress sdkTypes.AccAddress, pegHash types.PegHash) sdkTypes.Error
+}
+
+type FiatKeeper interface {
+	SendFiat(ctx sdkTypes.Context, fromAddress sdkTypes.AccAddress, toAddress sdkTypes.AccAddress, amount int64) sdkTypes.Error
+}
+
+type ContractKeeper interface {
+	GetNegotiation(ctx sdkTypes.Context, negotiationID string) (contract.Negotiation, bool)
+}
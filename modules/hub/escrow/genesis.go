@@ -1 +1,63 @@
-package asset
+package escrow
+
+import (
+	"fmt"
+	"strings"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+type GenesisState struct {
+	Escrows []Escrow `json:"escrows"`
+}
+
+func NewGenesisState(escrows []Escrow) GenesisState {
+	return GenesisState{
+		Escrows: escrows,
+	}
+}
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState([]Escrow{})
+}
+func InitGenesis(ctx sdkTypes.Context, keeper Keeper, genesisState GenesisState) {
+	for _, escrow := range genesisState.Escrows {
+		keeper.SetEscrow(ctx, escrow)
+		if escrow.Status == StatusOpen {
+			keeper.InsertEscrowQueue(ctx, escrow)
+		}
+	}
+}
+func ExportGenesis(ctx sdkTypes.Context, keeper Keeper) GenesisState {
+	var escrows []Escrow
+	keeper.IterateEscrows(ctx, func(escrow Escrow) (stop bool) {
+		escrows = append(escrows, escrow)
+		return false
+	})
+	return NewGenesisState(escrows)
+}
+func ValidateGenesis(genesisState GenesisState) error {
+	seenOrderIDs := make(map[string]bool)
+	for _, escrow := range genesisState.Escrows {
+		if len(strings.TrimSpace(escrow.OrderID)) == 0 {
+			return fmt.Errorf("escrow has an empty order id")
+		}
+		if seenOrderIDs[escrow.OrderID] {
+			return fmt.Errorf("duplicate escrow for order %s", escrow.OrderID)
+		}
+		seenOrderIDs[escrow.OrderID] = true
+
+		if escrow.BuyerAddress.Empty() || escrow.SellerAddress.Empty() {
+			return fmt.Errorf("escrow for order %s has an empty buyer or seller address", escrow.OrderID)
+		}
+		if escrow.PegHash.Empty() {
+			return fmt.Errorf("escrow for order %s has an empty peg hash", escrow.OrderID)
+		}
+		if escrow.FiatAmount <= 0 {
+			return fmt.Errorf("escrow for order %s has a non-positive fiat amount %d", escrow.OrderID, escrow.FiatAmount)
+		}
+		if escrow.Status.String() == "" {
+			return fmt.Errorf("escrow for order %s has an invalid status %d", escrow.OrderID, escrow.Status)
+		}
+	}
+	return nil
+}
@@ -1 +1,71 @@
-package asset
+package escrow
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewHandler(keeper Keeper) sdkTypes.Handler {
+	return func(ctx sdkTypes.Context, msg sdkTypes.Msg) sdkTypes.Result {
+		switch msg := msg.(type) {
+		case MsgLockFiat:
+			return handleMsgLockFiat(ctx, keeper, msg)
+		case MsgLockAsset:
+			return handleMsgLockAsset(ctx, keeper, msg)
+		case MsgRefundEscrow:
+			return handleMsgRefundEscrow(ctx, keeper, msg)
+		default:
+			errMsg := fmt.Sprintf("unrecognized escrow message type: %T", msg)
+			return sdkTypes.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+func handleMsgLockFiat(ctx sdkTypes.Context, keeper Keeper, msg MsgLockFiat) sdkTypes.Result {
+	escrow, err := keeper.LockFiat(ctx, msg.OrderID, msg.BuyerAddress)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdkTypes.NewEvent(msg.Type(), escrowAttributes(escrow)...),
+	)
+	return sdkTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+func handleMsgLockAsset(ctx sdkTypes.Context, keeper Keeper, msg MsgLockAsset) sdkTypes.Result {
+	escrow, err := keeper.LockAsset(ctx, msg.OrderID, msg.SellerAddress)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdkTypes.NewEvent(msg.Type(), escrowAttributes(escrow)...),
+	)
+	return sdkTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+func handleMsgRefundEscrow(ctx sdkTypes.Context, keeper Keeper, msg MsgRefundEscrow) sdkTypes.Result {
+	escrow, err := keeper.RefundExpiredEscrow(ctx, msg.OrderID)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdkTypes.NewEvent(EventTypeEscrowRefunded, escrowAttributes(escrow)...),
+	)
+	return sdkTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+func escrowAttributes(escrow Escrow) []sdkTypes.Attribute {
+	return []sdkTypes.Attribute{
+		sdkTypes.NewAttribute(AttributeKeyOrderID, escrow.OrderID),
+		sdkTypes.NewAttribute(AttributeKeyBuyer, escrow.BuyerAddress.String()),
+		sdkTypes.NewAttribute(AttributeKeySeller, escrow.SellerAddress.String()),
+		sdkTypes.NewAttribute(AttributeKeyPegHash, escrow.PegHash.String()),
+		sdkTypes.NewAttribute(AttributeKeyStatus, escrow.Status.String()),
+	}
+}
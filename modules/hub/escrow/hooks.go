@@ -0,0 +1,21 @@
+package escrow
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+type EscrowHooks interface {
+	AfterEscrowSettled(ctx sdkTypes.Context, escrow Escrow)
+	AfterEscrowRefunded(ctx sdkTypes.Context, escrow Escrow)
+}
+
+func (keeper Keeper) AfterEscrowSettled(ctx sdkTypes.Context, escrow Escrow) {
+	if keeper.hooks != nil {
+		keeper.hooks.AfterEscrowSettled(ctx, escrow)
+	}
+}
+func (keeper Keeper) AfterEscrowRefunded(ctx sdkTypes.Context, escrow Escrow) {
+	if keeper.hooks != nil {
+		keeper.hooks.AfterEscrowRefunded(ctx, escrow)
+	}
+}
@@ -1 +1,230 @@
-package asset
+package escrow
+
+import (
+	"encoding/binary"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/hub/contract"
+)
+
+const (
+	StoreKey     = "escrow"
+	QuerierRoute = "escrow"
+)
+
+var (
+	EscrowKeyPrefix      = []byte{0x01}
+	EscrowQueueKeyPrefix = []byte{0x02}
+)
+
+func GetEscrowKey(orderID string) []byte {
+	return append(EscrowKeyPrefix, []byte(orderID)...)
+}
+func GetEscrowQueueHeightKey(height int64) []byte {
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, uint64(height))
+	return append(EscrowQueueKeyPrefix, heightBytes...)
+}
+func GetEscrowQueueKey(deadline int64, orderID string) []byte {
+	return append(GetEscrowQueueHeightKey(deadline), []byte(orderID)...)
+}
+func GetEscrowAddress(orderID string) sdkTypes.AccAddress {
+	return sdkTypes.AccAddress(crypto.AddressHash([]byte("escrow/" + orderID)))
+}
+
+type Keeper struct {
+	storeKey       sdkTypes.StoreKey
+	cdc            *codec.Codec
+	assetKeeper    AssetKeeper
+	fiatKeeper     FiatKeeper
+	contractKeeper ContractKeeper
+	hooks          EscrowHooks
+	codespace      sdkTypes.CodespaceType
+}
+
+func NewKeeper(cdc *codec.Codec, storeKey sdkTypes.StoreKey, assetKeeper AssetKeeper, fiatKeeper FiatKeeper, contractKeeper ContractKeeper, codespace sdkTypes.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:       storeKey,
+		cdc:            cdc,
+		assetKeeper:    assetKeeper,
+		fiatKeeper:     fiatKeeper,
+		contractKeeper: contractKeeper,
+		codespace:      codespace,
+	}
+}
+func (keeper *Keeper) SetHooks(hooks EscrowHooks) *Keeper {
+	if keeper.hooks != nil {
+		panic("cannot set escrow hooks twice")
+	}
+	keeper.hooks = hooks
+	return keeper
+}
+func (keeper Keeper) Codespace() sdkTypes.CodespaceType {
+	return keeper.codespace
+}
+func (keeper Keeper) GetEscrow(ctx sdkTypes.Context, orderID string) (Escrow, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	escrowBytes := store.Get(GetEscrowKey(orderID))
+	if escrowBytes == nil {
+		return Escrow{}, false
+	}
+
+	var escrow Escrow
+	keeper.cdc.MustUnmarshalBinaryBare(escrowBytes, &escrow)
+	return escrow, true
+}
+func (keeper Keeper) SetEscrow(ctx sdkTypes.Context, escrow Escrow) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(GetEscrowKey(escrow.OrderID), keeper.cdc.MustMarshalBinaryBare(escrow))
+}
+func (keeper Keeper) IterateEscrows(ctx sdkTypes.Context, handler func(escrow Escrow) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdkTypes.KVStorePrefixIterator(store, EscrowKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var escrow Escrow
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &escrow)
+		if handler(escrow) {
+			break
+		}
+	}
+}
+func (keeper Keeper) InsertEscrowQueue(ctx sdkTypes.Context, escrow Escrow) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(GetEscrowQueueKey(escrow.Deadline, escrow.OrderID), []byte(escrow.OrderID))
+}
+func (keeper Keeper) RemoveFromEscrowQueue(ctx sdkTypes.Context, escrow Escrow) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(GetEscrowQueueKey(escrow.Deadline, escrow.OrderID))
+}
+func (keeper Keeper) removeEscrowQueueKey(ctx sdkTypes.Context, queueKey []byte) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(queueKey)
+}
+func (keeper Keeper) ExpiredEscrowQueueIterator(ctx sdkTypes.Context, height int64) sdkTypes.Iterator {
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Iterator(EscrowQueueKeyPrefix, sdkTypes.PrefixEndBytes(GetEscrowQueueHeightKey(height)))
+}
+func (keeper Keeper) getOpenEscrow(ctx sdkTypes.Context, orderID string) (Escrow, sdkTypes.Error) {
+	negotiation, found := keeper.contractKeeper.GetNegotiation(ctx, orderID)
+	if !found {
+		return Escrow{}, ErrContractNotFound(keeper.codespace, orderID)
+	}
+	if negotiation.Status != contract.StatusAccepted || !negotiation.IsSigned() {
+		return Escrow{}, ErrContractNotAccepted(keeper.codespace, orderID)
+	}
+	terms := negotiation.Terms
+
+	escrow, found := keeper.GetEscrow(ctx, orderID)
+	if !found {
+		if terms.Time <= ctx.BlockHeight() {
+			return Escrow{}, ErrInvalidDeadline(keeper.codespace, terms.Time)
+		}
+		return NewEscrow(orderID, terms.BuyerAddress, terms.SellerAddress, terms.PegHash, terms.Bid, terms.Time), nil
+	}
+	if escrow.Status != StatusOpen {
+		return Escrow{}, ErrEscrowClosed(keeper.codespace, orderID)
+	}
+	if !escrow.MatchesTerms(terms.BuyerAddress, terms.SellerAddress, terms.PegHash, terms.Bid, terms.Time) {
+		return Escrow{}, ErrTermsMismatch(keeper.codespace, orderID)
+	}
+	return escrow, nil
+}
+func (keeper Keeper) LockFiat(ctx sdkTypes.Context, orderID string, buyerAddress sdkTypes.AccAddress) (Escrow, sdkTypes.Error) {
+	escrow, err := keeper.getOpenEscrow(ctx, orderID)
+	if err != nil {
+		return Escrow{}, err
+	}
+	if !escrow.BuyerAddress.Equals(buyerAddress) {
+		return Escrow{}, ErrUnauthorizedParty(keeper.codespace, buyerAddress, "fiat", orderID)
+	}
+	if escrow.FiatLocked {
+		return Escrow{}, ErrLegAlreadyLocked(keeper.codespace, orderID, "fiat")
+	}
+
+	if err := keeper.fiatKeeper.SendFiat(ctx, escrow.BuyerAddress, GetEscrowAddress(orderID), escrow.FiatAmount); err != nil {
+		return Escrow{}, err
+	}
+	escrow.FiatLocked = true
+	return keeper.settleIfReady(ctx, escrow)
+}
+func (keeper Keeper) LockAsset(ctx sdkTypes.Context, orderID string, sellerAddress sdkTypes.AccAddress) (Escrow, sdkTypes.Error) {
+	escrow, err := keeper.getOpenEscrow(ctx, orderID)
+	if err != nil {
+		return Escrow{}, err
+	}
+	if !escrow.SellerAddress.Equals(sellerAddress) {
+		return Escrow{}, ErrUnauthorizedParty(keeper.codespace, sellerAddress, "asset", orderID)
+	}
+	if escrow.AssetLocked {
+		return Escrow{}, ErrLegAlreadyLocked(keeper.codespace, orderID, "asset")
+	}
+
+	if err := keeper.assetKeeper.LockAsset(ctx, escrow.SellerAddress, escrow.PegHash); err != nil {
+		return Escrow{}, err
+	}
+	escrow.AssetLocked = true
+	return keeper.settleIfReady(ctx, escrow)
+}
+func (keeper Keeper) settleIfReady(ctx sdkTypes.Context, escrow Escrow) (Escrow, sdkTypes.Error) {
+	if escrow.FiatLocked && escrow.AssetLocked {
+		if err := keeper.fiatKeeper.SendFiat(ctx, GetEscrowAddress(escrow.OrderID), escrow.SellerAddress, escrow.FiatAmount); err != nil {
+			return Escrow{}, err
+		}
+		if err := keeper.assetKeeper.UnlockAsset(ctx, escrow.PegHash); err != nil {
+			return Escrow{}, err
+		}
+		if err := keeper.assetKeeper.SendAsset(ctx, escrow.SellerAddress, escrow.BuyerAddress, escrow.PegHash); err != nil {
+			return Escrow{}, err
+		}
+		escrow.Status = StatusSettled
+		keeper.RemoveFromEscrowQueue(ctx, escrow)
+		keeper.AfterEscrowSettled(ctx, escrow)
+	} else {
+		keeper.InsertEscrowQueue(ctx, escrow)
+	}
+
+	keeper.SetEscrow(ctx, escrow)
+	return escrow, nil
+}
+func (keeper Keeper) RefundExpiredEscrow(ctx sdkTypes.Context, orderID string) (Escrow, sdkTypes.Error) {
+	escrow, found := keeper.GetEscrow(ctx, orderID)
+	if !found {
+		return Escrow{}, ErrEscrowNotFound(keeper.codespace, orderID)
+	}
+	if escrow.Deadline > ctx.BlockHeight() {
+		return Escrow{}, ErrEscrowNotExpired(keeper.codespace, orderID, escrow.Deadline)
+	}
+	return keeper.RefundEscrow(ctx, orderID)
+}
+func (keeper Keeper) RefundEscrow(ctx sdkTypes.Context, orderID string) (Escrow, sdkTypes.Error) {
+	escrow, found := keeper.GetEscrow(ctx, orderID)
+	if !found {
+		return Escrow{}, ErrEscrowNotFound(keeper.codespace, orderID)
+	}
+	if escrow.Status != StatusOpen {
+		return Escrow{}, ErrEscrowClosed(keeper.codespace, orderID)
+	}
+
+	if escrow.FiatLocked {
+		if err := keeper.fiatKeeper.SendFiat(ctx, GetEscrowAddress(orderID), escrow.BuyerAddress, escrow.FiatAmount); err != nil {
+			return Escrow{}, err
+		}
+	}
+	if escrow.AssetLocked {
+		if err := keeper.assetKeeper.UnlockAsset(ctx, escrow.PegHash); err != nil {
+			return Escrow{}, err
+		}
+	}
+	escrow.Status = StatusRefunded
+	keeper.RemoveFromEscrowQueue(ctx, escrow)
+	keeper.AfterEscrowRefunded(ctx, escrow)
+
+	keeper.SetEscrow(ctx, escrow)
+	return escrow, nil
+}
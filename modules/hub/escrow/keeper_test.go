@@ -0,0 +1,209 @@
+package escrow
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/commitHub/commitBlockchain/modules/hub/contract"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+type testAssetKeeper struct{}
+
+func (testAssetKeeper) LockAsset(ctx sdkTypes.Context, ownerAddress sdkTypes.AccAddress, pegHash types.PegHash) sdkTypes.Error {
+	return nil
+}
+func (testAssetKeeper) UnlockAsset(ctx sdkTypes.Context, pegHash types.PegHash) sdkTypes.Error {
+	return nil
+}
+func (testAssetKeeper) SendAsset(ctx sdkTypes.Context, fromAddress sdkTypes.AccAddress, toAddress sdkTypes.AccAddress, pegHash types.PegHash) sdkTypes.Error {
+	return nil
+}
+
+// testFiatKeeper fails every send while failing is set, standing in for an
+// escrow address whose fiat cannot be moved yet.
+type testFiatKeeper struct {
+	failing *bool
+}
+
+func (fiatKeeper testFiatKeeper) SendFiat(ctx sdkTypes.Context, fromAddress sdkTypes.AccAddress, toAddress sdkTypes.AccAddress, amount int64) sdkTypes.Error {
+	if *fiatKeeper.failing {
+		return sdkTypes.ErrInsufficientCoins("escrow address has no fiat to send")
+	}
+	return nil
+}
+
+type testContractKeeper struct {
+	negotiations map[string]contract.Negotiation
+}
+
+func (contractKeeper testContractKeeper) GetNegotiation(ctx sdkTypes.Context, negotiationID string) (contract.Negotiation, bool) {
+	negotiation, found := contractKeeper.negotiations[negotiationID]
+	return negotiation, found
+}
+
+func newTestContext(t *testing.T, storeKey sdkTypes.StoreKey, height int64) sdkTypes.Context {
+	multiStore := store.NewCommitMultiStore(dbm.NewMemDB())
+	multiStore.MountStoreWithDB(storeKey, sdkTypes.StoreTypeIAVL, nil)
+	if err := multiStore.LoadLatestVersion(); err != nil {
+		t.Fatal(err)
+	}
+	return sdkTypes.NewContext(multiStore, abci.Header{Height: height}, false, log.NewNopLogger())
+}
+
+func newTestAddress() sdkTypes.AccAddress {
+	return sdkTypes.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+}
+
+func hasEvent(events sdkTypes.Events, eventType string) bool {
+	for _, event := range events {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func TestRefundExpiredEscrowRetriesAfterFailure(t *testing.T) {
+	cdc := codec.New()
+	storeKey := sdkTypes.NewKVStoreKey(StoreKey)
+	failing := true
+	keeper := NewKeeper(cdc, storeKey, testAssetKeeper{}, testFiatKeeper{failing: &failing}, testContractKeeper{}, DefaultCodespace)
+	handler := NewHandler(keeper)
+
+	escrow := NewEscrow("order", newTestAddress(), newTestAddress(), types.PegHash("peg"), 100, 10)
+	escrow.FiatLocked = true
+	escrow.AssetLocked = true
+
+	ctx := newTestContext(t, storeKey, 5)
+	keeper.SetEscrow(ctx, escrow)
+	keeper.InsertEscrowQueue(ctx, escrow)
+
+	refundMsg := NewMsgRefundEscrow(newTestAddress(), escrow.OrderID)
+	if result := handler(ctx, refundMsg); result.IsOK() || result.Code != CodeEscrowNotExpired {
+		t.Fatalf("expected refunding before the deadline to fail with %d, got %v", CodeEscrowNotExpired, result)
+	}
+
+	ctx = ctx.WithBlockHeight(escrow.Deadline).WithEventManager(sdkTypes.NewEventManager())
+	EndBlocker(ctx, keeper)
+	if !hasEvent(ctx.EventManager().Events(), EventTypeEscrowRefundFailed) {
+		t.Fatalf("expected a %s event", EventTypeEscrowRefundFailed)
+	}
+	if stored, _ := keeper.GetEscrow(ctx, escrow.OrderID); stored.Status != StatusOpen {
+		t.Fatalf("expected a failed refund to leave the escrow open, got %s", stored.Status)
+	}
+	iterator := keeper.ExpiredEscrowQueueIterator(ctx, ctx.BlockHeight())
+	if !iterator.Valid() || string(iterator.Value()) != escrow.OrderID {
+		t.Fatal("expected a failed refund to keep the escrow queued")
+	}
+	iterator.Close()
+
+	if result := handler(ctx, refundMsg); result.IsOK() {
+		t.Fatal("expected refunding to fail while the fiat keeper fails")
+	}
+
+	failing = false
+	ctx = ctx.WithBlockHeight(escrow.Deadline + 1).WithEventManager(sdkTypes.NewEventManager())
+	if result := handler(ctx, refundMsg); !result.IsOK() {
+		t.Fatalf("expected the refund to succeed, got %v", result)
+	}
+	if stored, _ := keeper.GetEscrow(ctx, escrow.OrderID); stored.Status != StatusRefunded {
+		t.Fatalf("expected the escrow to be refunded, got %s", stored.Status)
+	}
+	iterator = keeper.ExpiredEscrowQueueIterator(ctx, ctx.BlockHeight())
+	if iterator.Valid() {
+		t.Fatal("expected the refunded escrow to leave the queue")
+	}
+	iterator.Close()
+
+	if result := handler(ctx, refundMsg); result.IsOK() || result.Code != CodeEscrowClosed {
+		t.Fatalf("expected refunding twice to fail with %d, got %v", CodeEscrowClosed, result)
+	}
+}
+
+func TestEndBlockerRefundsOnceFiatIsAvailable(t *testing.T) {
+	cdc := codec.New()
+	storeKey := sdkTypes.NewKVStoreKey(StoreKey)
+	failing := true
+	keeper := NewKeeper(cdc, storeKey, testAssetKeeper{}, testFiatKeeper{failing: &failing}, testContractKeeper{}, DefaultCodespace)
+
+	escrow := NewEscrow("order", newTestAddress(), newTestAddress(), types.PegHash("peg"), 100, 10)
+	escrow.FiatLocked = true
+
+	ctx := newTestContext(t, storeKey, escrow.Deadline)
+	keeper.SetEscrow(ctx, escrow)
+	keeper.InsertEscrowQueue(ctx, escrow)
+
+	EndBlocker(ctx, keeper)
+	failing = false
+	ctx = ctx.WithBlockHeight(escrow.Deadline + 1).WithEventManager(sdkTypes.NewEventManager())
+	EndBlocker(ctx, keeper)
+
+	if !hasEvent(ctx.EventManager().Events(), EventTypeEscrowRefunded) {
+		t.Fatalf("expected a %s event on retry", EventTypeEscrowRefunded)
+	}
+	if stored, _ := keeper.GetEscrow(ctx, escrow.OrderID); stored.Status != StatusRefunded {
+		t.Fatalf("expected the escrow to be refunded on retry, got %s", stored.Status)
+	}
+}
+
+func TestLockRequiresSignedNegotiation(t *testing.T) {
+	cdc := codec.New()
+	storeKey := sdkTypes.NewKVStoreKey(StoreKey)
+	failing := false
+	contractKeeper := testContractKeeper{negotiations: make(map[string]contract.Negotiation)}
+	keeper := NewKeeper(cdc, storeKey, testAssetKeeper{}, testFiatKeeper{failing: &failing}, contractKeeper, DefaultCodespace)
+	handler := NewHandler(keeper)
+	ctx := newTestContext(t, storeKey, 5)
+
+	buyerAddress, sellerAddress := newTestAddress(), newTestAddress()
+	terms := contract.NewTerms(buyerAddress, sellerAddress, types.PegHash("peg"), 100, 10)
+
+	if result := handler(ctx, NewMsgLockFiat("order", buyerAddress)); result.Code != CodeContractNotFound {
+		t.Fatalf("expected locking without a contract to fail with %d, got %v", CodeContractNotFound, result)
+	}
+
+	negotiation := contract.NewNegotiation("order", terms, buyerAddress)
+	negotiation.SetSignature(buyerAddress, []byte("buyerSignature"))
+	contractKeeper.negotiations["order"] = negotiation
+	if result := handler(ctx, NewMsgLockFiat("order", buyerAddress)); result.Code != CodeContractNotAccepted {
+		t.Fatalf("expected locking an open contract to fail with %d, got %v", CodeContractNotAccepted, result)
+	}
+
+	negotiation.Status = contract.StatusAccepted
+	contractKeeper.negotiations["order"] = negotiation
+	if result := handler(ctx, NewMsgLockFiat("order", buyerAddress)); result.Code != CodeContractNotAccepted {
+		t.Fatalf("expected locking a contract without the seller's signature to fail with %d, got %v", CodeContractNotAccepted, result)
+	}
+
+	negotiation.SetSignature(sellerAddress, []byte("sellerSignature"))
+	contractKeeper.negotiations["order"] = negotiation
+	if result := handler(ctx, NewMsgLockFiat("order", sellerAddress)); result.Code != CodeUnauthorizedParty {
+		t.Fatalf("expected the seller locking fiat to fail with %d, got %v", CodeUnauthorizedParty, result)
+	}
+	if result := handler(ctx, NewMsgLockAsset("order", newTestAddress())); result.Code != CodeUnauthorizedParty {
+		t.Fatalf("expected a non-party locking the asset to fail with %d, got %v", CodeUnauthorizedParty, result)
+	}
+
+	if result := handler(ctx, NewMsgLockFiat("order", buyerAddress)); !result.IsOK() {
+		t.Fatalf("expected the buyer to lock fiat, got %v", result)
+	}
+	escrow, found := keeper.GetEscrow(ctx, "order")
+	if !found || !escrow.MatchesTerms(terms.BuyerAddress, terms.SellerAddress, terms.PegHash, terms.Bid, terms.Time) || !escrow.FiatLocked {
+		t.Fatalf("expected the escrow to take the contract terms, got %v", escrow)
+	}
+
+	if result := handler(ctx, NewMsgLockAsset("order", sellerAddress)); !result.IsOK() {
+		t.Fatalf("expected the seller to lock the asset, got %v", result)
+	}
+	if escrow, _ := keeper.GetEscrow(ctx, "order"); escrow.Status != StatusSettled {
+		t.Fatalf("expected the escrow to settle, got %s", escrow.Status)
+	}
+}
@@ -1 +1,100 @@
-package asset
+package escrow
+
+import (
+	"strings"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+const RouterKey = "escrow"
+
+type MsgLockFiat struct {
+	OrderID      string              `json:"orderID"`
+	BuyerAddress sdkTypes.AccAddress `json:"buyerAddress"`
+}
+
+var _ sdkTypes.Msg = MsgLockFiat{}
+
+func NewMsgLockFiat(orderID string, buyerAddress sdkTypes.AccAddress) MsgLockFiat {
+	return MsgLockFiat{
+		OrderID:      orderID,
+		BuyerAddress: buyerAddress,
+	}
+}
+func (msg MsgLockFiat) Route() string { return RouterKey }
+func (msg MsgLockFiat) Type() string  { return "lockFiat" }
+func (msg MsgLockFiat) ValidateBasic() sdkTypes.Error {
+	if msg.BuyerAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing buyer address")
+	}
+	return validateOrderID(msg.OrderID)
+}
+func (msg MsgLockFiat) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+func (msg MsgLockFiat) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.BuyerAddress}
+}
+
+type MsgLockAsset struct {
+	OrderID       string              `json:"orderID"`
+	SellerAddress sdkTypes.AccAddress `json:"sellerAddress"`
+}
+
+var _ sdkTypes.Msg = MsgLockAsset{}
+
+func NewMsgLockAsset(orderID string, sellerAddress sdkTypes.AccAddress) MsgLockAsset {
+	return MsgLockAsset{
+		OrderID:       orderID,
+		SellerAddress: sellerAddress,
+	}
+}
+func (msg MsgLockAsset) Route() string { return RouterKey }
+func (msg MsgLockAsset) Type() string  { return "lockAsset" }
+func (msg MsgLockAsset) ValidateBasic() sdkTypes.Error {
+	if msg.SellerAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing seller address")
+	}
+	return validateOrderID(msg.OrderID)
+}
+func (msg MsgLockAsset) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+func (msg MsgLockAsset) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.SellerAddress}
+}
+
+type MsgRefundEscrow struct {
+	SenderAddress sdkTypes.AccAddress `json:"senderAddress"`
+	OrderID       string              `json:"orderID"`
+}
+
+var _ sdkTypes.Msg = MsgRefundEscrow{}
+
+func NewMsgRefundEscrow(senderAddress sdkTypes.AccAddress, orderID string) MsgRefundEscrow {
+	return MsgRefundEscrow{
+		SenderAddress: senderAddress,
+		OrderID:       orderID,
+	}
+}
+func (msg MsgRefundEscrow) Route() string { return RouterKey }
+func (msg MsgRefundEscrow) Type() string  { return "refundEscrow" }
+func (msg MsgRefundEscrow) ValidateBasic() sdkTypes.Error {
+	if msg.SenderAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing sender address")
+	}
+	return validateOrderID(msg.OrderID)
+}
+func (msg MsgRefundEscrow) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+func (msg MsgRefundEscrow) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.SenderAddress}
+}
+
+func validateOrderID(orderID string) sdkTypes.Error {
+	if len(strings.TrimSpace(orderID)) == 0 {
+		return ErrInvalidOrderID(DefaultCodespace)
+	}
+	return nil
+}
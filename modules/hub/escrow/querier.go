@@ -1 +1,52 @@
-package asset
+package escrow
+
+import (
+	"fmt"
+
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	QueryEscrow = "escrow"
+)
+
+type QueryEscrowParams struct {
+	OrderID string `json:"orderID"`
+}
+
+func NewQueryEscrowParams(orderID string) QueryEscrowParams {
+	return QueryEscrowParams{
+		OrderID: orderID,
+	}
+}
+
+func NewQuerier(keeper Keeper) sdkTypes.Querier {
+	return func(ctx sdkTypes.Context, path []string, req abciTypes.RequestQuery) ([]byte, sdkTypes.Error) {
+		switch path[0] {
+		case QueryEscrow:
+			return queryEscrow(ctx, req, keeper)
+		default:
+			return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("unknown escrow query endpoint: %s", path[0]))
+		}
+	}
+}
+func queryEscrow(ctx sdkTypes.Context, req abciTypes.RequestQuery, keeper Keeper) ([]byte, sdkTypes.Error) {
+	var params QueryEscrowParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkTypes.ErrUnknownRequest(sdkTypes.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	escrow, found := keeper.GetEscrow(ctx, params.OrderID)
+	if !found {
+		return nil, ErrEscrowNotFound(keeper.codespace, params.OrderID)
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, escrow)
+	if err != nil {
+		return nil, sdkTypes.ErrInternal(sdkTypes.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+	return res, nil
+}
@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/hub/fiat"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+func GetQueryCommand(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	command := &cobra.Command{
+		Use:                        fiat.QuerierRoute,
+		Short:                      "Querying commands for fiat",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	command.AddCommand(client.GetCommands(
+		QueryFiatCommand(queryRoute, cdc),
+		QueryOwnerFiatsCommand(queryRoute, cdc),
+		QueryParamsCommand(queryRoute, cdc),
+	)...)
+	return command
+}
+func QueryFiatCommand(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "fiat [peg-hash]",
+		Short: "Query a fiat peg by its peg hash",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			pegHash, err := types.GetPegHashHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			bytes, err := cdc.MarshalJSON(fiat.NewQueryFiatParams(pegHash))
+			if err != nil {
+				return err
+			}
+			res, _, err := cliContext.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, fiat.QueryFiat), bytes)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+func QueryOwnerFiatsCommand(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "wallet [address]",
+		Short: "Query the fiat peg wallet of an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			ownerAddress, err := sdkTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bytes, err := cdc.MarshalJSON(fiat.NewQueryOwnerFiatsParams(ownerAddress))
+			if err != nil {
+				return err
+			}
+			res, _, err := cliContext.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, fiat.QueryOwnerFiats), bytes)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+func QueryParamsCommand(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Query the fiat module parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliContext.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, fiat.QueryParams), nil)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
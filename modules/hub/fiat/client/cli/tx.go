@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/commitHub/commitBlockchain/modules/hub/fiat"
+)
+
+func GetTxCommand(cdc *codec.Codec) *cobra.Command {
+	command := &cobra.Command{
+		Use:                        fiat.RouterKey,
+		Short:                      "Fiat transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	command.AddCommand(client.PostCommands(
+		IssueFiatCommand(cdc),
+		RedeemFiatCommand(cdc),
+		SendFiatCommand(cdc),
+	)...)
+	return command
+}
+func IssueFiatCommand(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "issue [to-address] [transaction-id] [transaction-amount]",
+		Short: "Issue fiat against a bank transaction to an address",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(command *cobra.Command, args []string) error {
+			transactionBuilder := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			toAddress, err := sdkTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			transactionAmount, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := fiat.NewMsgIssueFiat(cliContext.GetFromAddress(), toAddress, args[1], transactionAmount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliContext, transactionBuilder, []sdkTypes.Msg{msg})
+		},
+	}
+}
+func RedeemFiatCommand(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "redeem [issuer-address] [amount]",
+		Short: "Redeem fiat with its issuer",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(command *cobra.Command, args []string) error {
+			transactionBuilder := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			issuerAddress, err := sdkTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			amount, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := fiat.NewMsgRedeemFiat(cliContext.GetFromAddress(), issuerAddress, amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliContext, transactionBuilder, []sdkTypes.Msg{msg})
+		},
+	}
+}
+func SendFiatCommand(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "send [to-address] [amount]",
+		Short: "Send fiat to another address",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(command *cobra.Command, args []string) error {
+			transactionBuilder := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			toAddress, err := sdkTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			amount, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := fiat.NewMsgSendFiat(cliContext.GetFromAddress(), toAddress, amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliContext, transactionBuilder, []sdkTypes.Msg{msg})
+		},
+	}
+}
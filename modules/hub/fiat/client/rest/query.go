@@ -0,0 +1,47 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/commitHub/commitBlockchain/modules/hub/fiat"
+	"github.com/commitHub/commitBlockchain/types"
+	restTypes "github.com/commitHub/commitBlockchain/types/rest"
+)
+
+func registerQueryRoutes(cliContext context.CLIContext, router *mux.Router) {
+	router.HandleFunc("/fiat/fiats/{pegHash}", queryFiatHandlerFunction(cliContext)).Methods("GET")
+	router.HandleFunc("/fiat/owners/{address}/fiats", queryOwnerFiatsHandlerFunction(cliContext)).Methods("GET")
+	router.HandleFunc("/fiat/parameters", queryParamsHandlerFunction(cliContext)).Methods("GET")
+}
+func queryFiatHandlerFunction(cliContext context.CLIContext) http.HandlerFunc {
+	return func(responseWriter http.ResponseWriter, request *http.Request) {
+		pegHash, err := types.GetPegHashHex(mux.Vars(request)["pegHash"])
+		if err != nil {
+			rest.WriteErrorResponse(responseWriter, http.StatusBadRequest, err.Error())
+			return
+		}
+		restTypes.QueryWithParams(responseWriter, request, cliContext, fmt.Sprintf("custom/%s/%s", fiat.QuerierRoute, fiat.QueryFiat), fiat.NewQueryFiatParams(pegHash))
+	}
+}
+func queryOwnerFiatsHandlerFunction(cliContext context.CLIContext) http.HandlerFunc {
+	return func(responseWriter http.ResponseWriter, request *http.Request) {
+		ownerAddress, err := sdkTypes.AccAddressFromBech32(mux.Vars(request)["address"])
+		if err != nil {
+			rest.WriteErrorResponse(responseWriter, http.StatusBadRequest, err.Error())
+			return
+		}
+		restTypes.QueryWithParams(responseWriter, request, cliContext, fmt.Sprintf("custom/%s/%s", fiat.QuerierRoute, fiat.QueryOwnerFiats), fiat.NewQueryOwnerFiatsParams(ownerAddress))
+	}
+}
+func queryParamsHandlerFunction(cliContext context.CLIContext) http.HandlerFunc {
+	return func(responseWriter http.ResponseWriter, request *http.Request) {
+		restTypes.QueryWithParams(responseWriter, request, cliContext, fmt.Sprintf("custom/%s/%s", fiat.QuerierRoute, fiat.QueryParams), nil)
+	}
+}
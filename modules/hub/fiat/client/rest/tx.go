@@ -0,0 +1,75 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/commitHub/commitBlockchain/modules/hub/fiat"
+	restTypes "github.com/commitHub/commitBlockchain/types/rest"
+)
+
+type IssueFiatRequest struct {
+	BaseRequest       rest.BaseReq        `json:"base_req"`
+	ToAddress         sdkTypes.AccAddress `json:"toAddress"`
+	TransactionID     string              `json:"transactionID"`
+	TransactionAmount int64               `json:"transactionAmount"`
+}
+
+type RedeemFiatRequest struct {
+	BaseRequest   rest.BaseReq        `json:"base_req"`
+	IssuerAddress sdkTypes.AccAddress `json:"issuerAddress"`
+	Amount        int64               `json:"amount"`
+}
+
+type SendFiatRequest struct {
+	BaseRequest rest.BaseReq        `json:"base_req"`
+	ToAddress   sdkTypes.AccAddress `json:"toAddress"`
+	Amount      int64               `json:"amount"`
+}
+
+func registerTxRoutes(cliContext context.CLIContext, router *mux.Router) {
+	router.HandleFunc("/fiat/issue", issueFiatHandlerFunction(cliContext)).Methods("POST")
+	router.HandleFunc("/fiat/redeem", redeemFiatHandlerFunction(cliContext)).Methods("POST")
+	router.HandleFunc("/fiat/send", sendFiatHandlerFunction(cliContext)).Methods("POST")
+}
+func issueFiatHandlerFunction(cliContext context.CLIContext) http.HandlerFunc {
+	return func(responseWriter http.ResponseWriter, request *http.Request) {
+		var issueFiatRequest IssueFiatRequest
+		fromAddress, ok := restTypes.ReadBaseRequest(responseWriter, request, cliContext, &issueFiatRequest, &issueFiatRequest.BaseRequest)
+		if !ok {
+			return
+		}
+
+		msg := fiat.NewMsgIssueFiat(fromAddress, issueFiatRequest.ToAddress, issueFiatRequest.TransactionID, issueFiatRequest.TransactionAmount)
+		restTypes.WriteGenerateStdTxResponse(responseWriter, cliContext, issueFiatRequest.BaseRequest, msg)
+	}
+}
+func redeemFiatHandlerFunction(cliContext context.CLIContext) http.HandlerFunc {
+	return func(responseWriter http.ResponseWriter, request *http.Request) {
+		var redeemFiatRequest RedeemFiatRequest
+		fromAddress, ok := restTypes.ReadBaseRequest(responseWriter, request, cliContext, &redeemFiatRequest, &redeemFiatRequest.BaseRequest)
+		if !ok {
+			return
+		}
+
+		msg := fiat.NewMsgRedeemFiat(fromAddress, redeemFiatRequest.IssuerAddress, redeemFiatRequest.Amount)
+		restTypes.WriteGenerateStdTxResponse(responseWriter, cliContext, redeemFiatRequest.BaseRequest, msg)
+	}
+}
+func sendFiatHandlerFunction(cliContext context.CLIContext) http.HandlerFunc {
+	return func(responseWriter http.ResponseWriter, request *http.Request) {
+		var sendFiatRequest SendFiatRequest
+		fromAddress, ok := restTypes.ReadBaseRequest(responseWriter, request, cliContext, &sendFiatRequest, &sendFiatRequest.BaseRequest)
+		if !ok {
+			return
+		}
+
+		msg := fiat.NewMsgSendFiat(fromAddress, sendFiatRequest.ToAddress, sendFiatRequest.Amount)
+		restTypes.WriteGenerateStdTxResponse(responseWriter, cliContext, sendFiatRequest.BaseRequest, msg)
+	}
+}
@@ -1 +1,17 @@
-package asset
+package fiat
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgIssueFiat{}, "commit/fiat/MsgIssueFiat", nil)
+	cdc.RegisterConcrete(MsgRedeemFiat{}, "commit/fiat/MsgRedeemFiat", nil)
+	cdc.RegisterConcrete(MsgSendFiat{}, "commit/fiat/MsgSendFiat", nil)
+}
+
+var msgCdc = codec.New()
+
+func init() {
+	RegisterCodec(msgCdc)
+}
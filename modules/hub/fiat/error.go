@@ -1 +1,35 @@
-package asset
+package fiat
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+const (
+	DefaultCodespace sdkTypes.CodespaceType = "fiat"
+
+	CodeFiatNotFound         sdkTypes.CodeType = 101
+	CodeUnauthorizedIssuer   sdkTypes.CodeType = 102
+	CodeTransactionIDExists  sdkTypes.CodeType = 103
+	CodeInvalidAmount        sdkTypes.CodeType = 104
+	CodeInvalidTransactionID sdkTypes.CodeType = 105
+)
+
+func ErrFiatNotFound(codespace sdkTypes.CodespaceType, pegHash types.PegHash) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeFiatNotFound, fmt.Sprintf("fiat with peg hash %s not found", pegHash))
+}
+func ErrUnauthorizedIssuer(codespace sdkTypes.CodespaceType, address sdkTypes.AccAddress) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeUnauthorizedIssuer, fmt.Sprintf("%s is not an authorized fiat issuer", address))
+}
+func ErrTransactionIDExists(codespace sdkTypes.CodespaceType, transactionID string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeTransactionIDExists, fmt.Sprintf("fiat has already been issued against transaction %s", transactionID))
+}
+func ErrInvalidAmount(codespace sdkTypes.CodespaceType, message string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeInvalidAmount, message)
+}
+func ErrInvalidTransactionID(codespace sdkTypes.CodespaceType) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeInvalidTransactionID, "transaction id cannot be empty")
+}
@@ -0,0 +1,11 @@
+package fiat
+
+var (
+	AttributeKeyPegHash       = "pegHash"
+	AttributeKeyTransactionID = "transactionID"
+	AttributeKeySender        = "sender"
+	AttributeKeyRecipient     = "recipient"
+	AttributeKeyIssuer        = "issuer"
+	AttributeKeyRedeemer      = "redeemer"
+	AttributeKeyAmount        = "amount"
+)
@@ -1 +1,108 @@
-package asset
+package fiat
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+type GenesisFiatPeg struct {
+	FiatPeg       types.BaseFiatPeg   `json:"fiatPeg"`
+	IssuerAddress sdkTypes.AccAddress `json:"issuerAddress"`
+}
+
+func NewGenesisFiatPeg(fiatPeg types.BaseFiatPeg, issuerAddress sdkTypes.AccAddress) GenesisFiatPeg {
+	return GenesisFiatPeg{
+		FiatPeg:       fiatPeg,
+		IssuerAddress: issuerAddress,
+	}
+}
+
+type GenesisState struct {
+	Params         Params           `json:"params"`
+	PegHashCounter uint64           `json:"pegHashCounter"`
+	FiatPegs       []GenesisFiatPeg `json:"fiatPegs"`
+	ZoneEscrows    []ZoneEscrow     `json:"zoneEscrows"`
+}
+
+func NewGenesisState(params Params, pegHashCounter uint64, fiatPegs []GenesisFiatPeg, zoneEscrows []ZoneEscrow) GenesisState {
+	return GenesisState{
+		Params:         params,
+		PegHashCounter: pegHashCounter,
+		FiatPegs:       fiatPegs,
+		ZoneEscrows:    zoneEscrows,
+	}
+}
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), 0, []GenesisFiatPeg{}, []ZoneEscrow{})
+}
+func InitGenesis(ctx sdkTypes.Context, keeper Keeper, genesisState GenesisState) {
+	keeper.SetParams(ctx, genesisState.Params)
+	for _, genesisFiatPeg := range genesisState.FiatPegs {
+		keeper.SetFiatPeg(ctx, genesisFiatPeg.FiatPeg)
+		keeper.setIssuer(ctx, genesisFiatPeg.FiatPeg.PegHash, genesisFiatPeg.IssuerAddress)
+		keeper.setPegHashByTransactionID(ctx, genesisFiatPeg.FiatPeg.TransactionID, genesisFiatPeg.FiatPeg.PegHash)
+	}
+	for _, zoneEscrow := range genesisState.ZoneEscrows {
+		keeper.SetZoneEscrow(ctx, zoneEscrow)
+	}
+	keeper.setPegHashCounter(ctx, genesisState.PegHashCounter)
+}
+func ExportGenesis(ctx sdkTypes.Context, keeper Keeper) GenesisState {
+	var fiatPegs []GenesisFiatPeg
+	keeper.IterateFiatPegs(ctx, func(fiatPeg types.BaseFiatPeg) (stop bool) {
+		fiatPegs = append(fiatPegs, NewGenesisFiatPeg(fiatPeg, keeper.GetIssuer(ctx, fiatPeg.PegHash)))
+		return false
+	})
+	var zoneEscrows []ZoneEscrow
+	keeper.IterateZoneEscrows(ctx, func(zoneEscrow ZoneEscrow) (stop bool) {
+		zoneEscrows = append(zoneEscrows, zoneEscrow)
+		return false
+	})
+	return NewGenesisState(keeper.GetParams(ctx), keeper.getPegHashCounter(ctx), fiatPegs, zoneEscrows)
+}
+func ValidateGenesis(genesisState GenesisState) error {
+	if err := genesisState.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenPegHashes := make(map[string]bool)
+	seenTransactionIDs := make(map[string]bool)
+	for _, genesisFiatPeg := range genesisState.FiatPegs {
+		fiatPeg := genesisFiatPeg.FiatPeg
+		if err := fiatPeg.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid fiat peg %s: %s", fiatPeg.PegHash, err.Error())
+		}
+		if seenPegHashes[fiatPeg.PegHash.String()] {
+			return fmt.Errorf("duplicate fiat peg %s", fiatPeg.PegHash)
+		}
+		seenPegHashes[fiatPeg.PegHash.String()] = true
+
+		if seenTransactionIDs[fiatPeg.TransactionID] {
+			return fmt.Errorf("duplicate fiat transaction id %s", fiatPeg.TransactionID)
+		}
+		seenTransactionIDs[fiatPeg.TransactionID] = true
+
+		if genesisFiatPeg.IssuerAddress.Empty() {
+			return fmt.Errorf("fiat peg %s has an empty issuer address", fiatPeg.PegHash)
+		}
+		if len(fiatPeg.PegHash) == 8 && binary.BigEndian.Uint64(fiatPeg.PegHash) > genesisState.PegHashCounter {
+			return fmt.Errorf("fiat peg %s is ahead of the peg hash counter %d", fiatPeg.PegHash, genesisState.PegHashCounter)
+		}
+	}
+
+	seenChainIDs := make(map[string]bool)
+	for _, zoneEscrow := range genesisState.ZoneEscrows {
+		if err := zoneEscrow.Validate(); err != nil {
+			return err
+		}
+		if seenChainIDs[zoneEscrow.ChainID] {
+			return fmt.Errorf("duplicate zone escrow for %s", zoneEscrow.ChainID)
+		}
+		seenChainIDs[zoneEscrow.ChainID] = true
+	}
+	return nil
+}
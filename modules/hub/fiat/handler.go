@@ -1 +1,77 @@
-package asset
+package fiat
+
+import (
+	"fmt"
+	"strconv"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewHandler(keeper Keeper) sdkTypes.Handler {
+	return func(ctx sdkTypes.Context, msg sdkTypes.Msg) sdkTypes.Result {
+		switch msg := msg.(type) {
+		case MsgIssueFiat:
+			return handleMsgIssueFiat(ctx, keeper, msg)
+		case MsgRedeemFiat:
+			return handleMsgRedeemFiat(ctx, keeper, msg)
+		case MsgSendFiat:
+			return handleMsgSendFiat(ctx, keeper, msg)
+		default:
+			errMsg := fmt.Sprintf("unrecognized fiat message type: %T", msg)
+			return sdkTypes.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+func handleMsgIssueFiat(ctx sdkTypes.Context, keeper Keeper, msg MsgIssueFiat) sdkTypes.Result {
+	fiatPeg, err := keeper.IssueFiat(ctx, msg.IssuerAddress, msg.ToAddress, msg.TransactionID, msg.TransactionAmount)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			msg.Type(),
+			sdkTypes.NewAttribute(AttributeKeyIssuer, msg.IssuerAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyRecipient, msg.ToAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyTransactionID, msg.TransactionID),
+			sdkTypes.NewAttribute(AttributeKeyPegHash, fiatPeg.PegHash.String()),
+		),
+	)
+	return sdkTypes.Result{
+		Data:   fiatPeg.PegHash,
+		Events: ctx.EventManager().Events(),
+	}
+}
+func handleMsgRedeemFiat(ctx sdkTypes.Context, keeper Keeper, msg MsgRedeemFiat) sdkTypes.Result {
+	if err := keeper.RedeemFiat(ctx, msg.RedeemerAddress, msg.IssuerAddress, msg.Amount); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			msg.Type(),
+			sdkTypes.NewAttribute(AttributeKeyRedeemer, msg.RedeemerAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyIssuer, msg.IssuerAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyAmount, strconv.FormatInt(msg.Amount, 10)),
+		),
+	)
+	return sdkTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+func handleMsgSendFiat(ctx sdkTypes.Context, keeper Keeper, msg MsgSendFiat) sdkTypes.Result {
+	if err := keeper.SendFiat(ctx, msg.FromAddress, msg.ToAddress, msg.Amount); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			msg.Type(),
+			sdkTypes.NewAttribute(AttributeKeySender, msg.FromAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyRecipient, msg.ToAddress.String()),
+		),
+	)
+	return sdkTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
@@ -1 +1,253 @@
-package asset
+package fiat
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+const (
+	StoreKey     = "fiat"
+	QuerierRoute = "fiat"
+)
+
+var (
+	PegHashCounterKey      = []byte{0x00}
+	FiatPegKeyPrefix       = []byte{0x01}
+	OwnerFiatPegKeyPrefix  = []byte{0x02}
+	IssuerKeyPrefix        = []byte{0x03}
+	TransactionIDKeyPrefix = []byte{0x04}
+	ZoneEscrowKeyPrefix    = []byte{0x05}
+)
+
+func GetFiatPegKey(pegHash types.PegHash) []byte {
+	return append(FiatPegKeyPrefix, pegHash.Bytes()...)
+}
+func GetOwnerFiatPegsKey(ownerAddress sdkTypes.AccAddress) []byte {
+	return append(OwnerFiatPegKeyPrefix, ownerAddress.Bytes()...)
+}
+func GetOwnerFiatPegKey(ownerAddress sdkTypes.AccAddress, pegHash types.PegHash) []byte {
+	return append(GetOwnerFiatPegsKey(ownerAddress), pegHash.Bytes()...)
+}
+func GetIssuerKey(pegHash types.PegHash) []byte {
+	return append(IssuerKeyPrefix, pegHash.Bytes()...)
+}
+func GetTransactionIDKey(transactionID string) []byte {
+	return append(TransactionIDKeyPrefix, []byte(transactionID)...)
+}
+func GetZoneEscrowKey(chainID string) []byte {
+	return append(ZoneEscrowKeyPrefix, []byte(chainID)...)
+}
+
+type Keeper struct {
+	storeKey   sdkTypes.StoreKey
+	cdc        *codec.Codec
+	paramSpace params.Subspace
+	codespace  sdkTypes.CodespaceType
+}
+
+func NewKeeper(cdc *codec.Codec, storeKey sdkTypes.StoreKey, paramSpace params.Subspace, codespace sdkTypes.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:   storeKey,
+		cdc:        cdc,
+		paramSpace: paramSpace.WithKeyTable(ParamKeyTable()),
+		codespace:  codespace,
+	}
+}
+func (keeper Keeper) Codespace() sdkTypes.CodespaceType {
+	return keeper.codespace
+}
+func (keeper Keeper) GetParams(ctx sdkTypes.Context) Params {
+	params := DefaultParams()
+	keeper.paramSpace.GetIfExists(ctx, ParamStoreKeyParams, &params)
+	return params
+}
+func (keeper Keeper) SetParams(ctx sdkTypes.Context, params Params) {
+	keeper.paramSpace.Set(ctx, ParamStoreKeyParams, &params)
+}
+func (keeper Keeper) getPegHashCounter(ctx sdkTypes.Context) uint64 {
+	store := ctx.KVStore(keeper.storeKey)
+	counterBytes := store.Get(PegHashCounterKey)
+	if counterBytes == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(counterBytes)
+}
+func (keeper Keeper) setPegHashCounter(ctx sdkTypes.Context, counter uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	counterBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(counterBytes, counter)
+	store.Set(PegHashCounterKey, counterBytes)
+}
+func (keeper Keeper) getNextPegHash(ctx sdkTypes.Context) types.PegHash {
+	counter := keeper.getPegHashCounter(ctx) + 1
+	keeper.setPegHashCounter(ctx, counter)
+
+	pegHash := make([]byte, 8)
+	binary.BigEndian.PutUint64(pegHash, counter)
+	return pegHash
+}
+func (keeper Keeper) GetFiatPeg(ctx sdkTypes.Context, pegHash types.PegHash) (types.BaseFiatPeg, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	fiatPegBytes := store.Get(GetFiatPegKey(pegHash))
+	if fiatPegBytes == nil {
+		return types.BaseFiatPeg{}, false
+	}
+
+	var fiatPeg types.BaseFiatPeg
+	keeper.cdc.MustUnmarshalBinaryBare(fiatPegBytes, &fiatPeg)
+	return fiatPeg, true
+}
+func (keeper Keeper) SetFiatPeg(ctx sdkTypes.Context, fiatPeg types.BaseFiatPeg) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	if oldFiatPeg, found := keeper.GetFiatPeg(ctx, fiatPeg.PegHash); found {
+		for _, owner := range oldFiatPeg.Owners {
+			store.Delete(GetOwnerFiatPegKey(owner.OwnerAddress, fiatPeg.PegHash))
+		}
+	}
+
+	store.Set(GetFiatPegKey(fiatPeg.PegHash), keeper.cdc.MustMarshalBinaryBare(fiatPeg))
+	for _, owner := range fiatPeg.Owners {
+		store.Set(GetOwnerFiatPegKey(owner.OwnerAddress, fiatPeg.PegHash), []byte{})
+	}
+}
+func (keeper Keeper) SetFiatPegWallet(ctx sdkTypes.Context, fiatPegWallet types.FiatPegWallet) {
+	for _, fiatPeg := range fiatPegWallet {
+		keeper.SetFiatPeg(ctx, fiatPeg)
+	}
+}
+func (keeper Keeper) GetFiatPegWallet(ctx sdkTypes.Context, ownerAddress sdkTypes.AccAddress) types.FiatPegWallet {
+	store := ctx.KVStore(keeper.storeKey)
+	ownerPrefix := GetOwnerFiatPegsKey(ownerAddress)
+	iterator := sdkTypes.KVStorePrefixIterator(store, ownerPrefix)
+	defer iterator.Close()
+
+	var fiatPegWallet types.FiatPegWallet
+	for ; iterator.Valid(); iterator.Next() {
+		pegHash := types.PegHash(iterator.Key()[len(ownerPrefix):])
+		if fiatPeg, found := keeper.GetFiatPeg(ctx, pegHash); found {
+			fiatPegWallet = append(fiatPegWallet, fiatPeg)
+		}
+	}
+	return fiatPegWallet.Sort()
+}
+func (keeper Keeper) IterateFiatPegs(ctx sdkTypes.Context, handler func(fiatPeg types.BaseFiatPeg) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdkTypes.KVStorePrefixIterator(store, FiatPegKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var fiatPeg types.BaseFiatPeg
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &fiatPeg)
+		if handler(fiatPeg) {
+			break
+		}
+	}
+}
+func (keeper Keeper) GetIssuer(ctx sdkTypes.Context, pegHash types.PegHash) sdkTypes.AccAddress {
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Get(GetIssuerKey(pegHash))
+}
+func (keeper Keeper) setIssuer(ctx sdkTypes.Context, pegHash types.PegHash, issuerAddress sdkTypes.AccAddress) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(GetIssuerKey(pegHash), issuerAddress.Bytes())
+}
+func (keeper Keeper) GetPegHashByTransactionID(ctx sdkTypes.Context, transactionID string) (types.PegHash, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	pegHash := store.Get(GetTransactionIDKey(transactionID))
+	return pegHash, pegHash != nil
+}
+func (keeper Keeper) setPegHashByTransactionID(ctx sdkTypes.Context, transactionID string, pegHash types.PegHash) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(GetTransactionIDKey(transactionID), pegHash.Bytes())
+}
+func (keeper Keeper) GetZoneEscrow(ctx sdkTypes.Context, chainID string) ZoneEscrow {
+	store := ctx.KVStore(keeper.storeKey)
+	zoneEscrowBytes := store.Get(GetZoneEscrowKey(chainID))
+	if zoneEscrowBytes == nil {
+		return NewZoneEscrow(chainID, 0, 0)
+	}
+
+	var zoneEscrow ZoneEscrow
+	keeper.cdc.MustUnmarshalBinaryBare(zoneEscrowBytes, &zoneEscrow)
+	return zoneEscrow
+}
+func (keeper Keeper) SetZoneEscrow(ctx sdkTypes.Context, zoneEscrow ZoneEscrow) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(GetZoneEscrowKey(zoneEscrow.ChainID), keeper.cdc.MustMarshalBinaryBare(zoneEscrow))
+}
+func (keeper Keeper) IterateZoneEscrows(ctx sdkTypes.Context, handler func(zoneEscrow ZoneEscrow) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdkTypes.KVStorePrefixIterator(store, ZoneEscrowKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var zoneEscrow ZoneEscrow
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &zoneEscrow)
+		if handler(zoneEscrow) {
+			break
+		}
+	}
+}
+func (keeper Keeper) IssueFiat(ctx sdkTypes.Context, issuerAddress sdkTypes.AccAddress, toAddress sdkTypes.AccAddress, transactionID string, transactionAmount int64) (types.BaseFiatPeg, sdkTypes.Error) {
+	if !keeper.GetParams(ctx).IsIssuer(issuerAddress) {
+		return types.BaseFiatPeg{}, ErrUnauthorizedIssuer(keeper.codespace, issuerAddress)
+	}
+	return keeper.mintFiat(ctx, issuerAddress, toAddress, transactionID, transactionAmount)
+}
+func (keeper Keeper) mintFiat(ctx sdkTypes.Context, issuerAddress sdkTypes.AccAddress, toAddress sdkTypes.AccAddress, transactionID string, transactionAmount int64) (types.BaseFiatPeg, sdkTypes.Error) {
+	if _, found := keeper.GetPegHashByTransactionID(ctx, transactionID); found {
+		return types.BaseFiatPeg{}, ErrTransactionIDExists(keeper.codespace, transactionID)
+	}
+
+	fiatPeg := types.NewBaseFiatPeg(keeper.getNextPegHash(ctx), transactionID, transactionAmount, toAddress)
+	if err := fiatPeg.ValidateBasic(); err != nil {
+		return types.BaseFiatPeg{}, err
+	}
+
+	keeper.SetFiatPeg(ctx, fiatPeg)
+	keeper.setIssuer(ctx, fiatPeg.PegHash, issuerAddress)
+	keeper.setPegHashByTransactionID(ctx, transactionID, fiatPeg.PegHash)
+	return fiatPeg, nil
+}
+func (keeper Keeper) SendFiat(ctx sdkTypes.Context, fromAddress sdkTypes.AccAddress, toAddress sdkTypes.AccAddress, amount int64) sdkTypes.Error {
+	fiatPegWallet, err := types.TransferAmountInWallet(keeper.GetFiatPegWallet(ctx, fromAddress), fromAddress, toAddress, amount)
+	if err != nil {
+		return err
+	}
+
+	keeper.SetFiatPegWallet(ctx, fiatPegWallet)
+	return nil
+}
+func (keeper Keeper) RedeemFiat(ctx sdkTypes.Context, redeemerAddress sdkTypes.AccAddress, issuerAddress sdkTypes.AccAddress, amount int64) sdkTypes.Error {
+	var issuedFiatPegWallet types.FiatPegWallet
+	for _, fiatPeg := range keeper.GetFiatPegWallet(ctx, redeemerAddress) {
+		if keeper.GetIssuer(ctx, fiatPeg.PegHash).Equals(issuerAddress) {
+			issuedFiatPegWallet = append(issuedFiatPegWallet, fiatPeg)
+		}
+	}
+
+	return keeper.burnFiat(ctx, issuedFiatPegWallet, redeemerAddress, amount)
+}
+func (keeper Keeper) burnFiat(ctx sdkTypes.Context, fiatPegWallet types.FiatPegWallet, ownerAddress sdkTypes.AccAddress, amount int64) sdkTypes.Error {
+	burnedFiatPegWallet, remainingFiatPegWallet, err := types.SubtractAmountFromWallet(fiatPegWallet, ownerAddress, amount)
+	if err != nil {
+		return err
+	}
+
+	for _, burnedFiatPeg := range burnedFiatPegWallet {
+		fiatPeg, _ := remainingFiatPegWallet.GetFiatPeg(burnedFiatPeg.PegHash)
+		redeemedAmount := fiatPeg.RedeemedAmount + burnedFiatPeg.GetOwnerAmount(ownerAddress)
+		if err := fiatPeg.SetRedeemedAmount(redeemedAmount); err != nil {
+			return ErrInvalidAmount(keeper.codespace, fmt.Sprintf("cannot redeem fiat peg %s: %s", fiatPeg.PegHash, err.Error()))
+		}
+		keeper.SetFiatPeg(ctx, fiatPeg)
+	}
+	return nil
+}
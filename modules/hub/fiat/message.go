@@ -1 +1,122 @@
-package asset
+package fiat
+
+import (
+	"strings"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+const RouterKey = "fiat"
+
+type MsgIssueFiat struct {
+	IssuerAddress     sdkTypes.AccAddress `json:"issuerAddress"`
+	ToAddress         sdkTypes.AccAddress `json:"toAddress"`
+	TransactionID     string              `json:"transactionID"`
+	TransactionAmount int64               `json:"transactionAmount"`
+}
+
+var _ sdkTypes.Msg = MsgIssueFiat{}
+
+func NewMsgIssueFiat(issuerAddress sdkTypes.AccAddress, toAddress sdkTypes.AccAddress, transactionID string, transactionAmount int64) MsgIssueFiat {
+	return MsgIssueFiat{
+		IssuerAddress:     issuerAddress,
+		ToAddress:         toAddress,
+		TransactionID:     transactionID,
+		TransactionAmount: transactionAmount,
+	}
+}
+func (msg MsgIssueFiat) Route() string { return RouterKey }
+func (msg MsgIssueFiat) Type() string  { return "issueFiat" }
+func (msg MsgIssueFiat) ValidateBasic() sdkTypes.Error {
+	if msg.IssuerAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing issuer address")
+	}
+	if msg.ToAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing recipient address")
+	}
+	if len(strings.TrimSpace(msg.TransactionID)) == 0 {
+		return ErrInvalidTransactionID(DefaultCodespace)
+	}
+	if msg.TransactionAmount <= 0 {
+		return ErrInvalidAmount(DefaultCodespace, "transaction amount must be positive")
+	}
+	return nil
+}
+func (msg MsgIssueFiat) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+func (msg MsgIssueFiat) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.IssuerAddress}
+}
+
+type MsgRedeemFiat struct {
+	RedeemerAddress sdkTypes.AccAddress `json:"redeemerAddress"`
+	IssuerAddress   sdkTypes.AccAddress `json:"issuerAddress"`
+	Amount          int64               `json:"amount"`
+}
+
+var _ sdkTypes.Msg = MsgRedeemFiat{}
+
+func NewMsgRedeemFiat(redeemerAddress sdkTypes.AccAddress, issuerAddress sdkTypes.AccAddress, amount int64) MsgRedeemFiat {
+	return MsgRedeemFiat{
+		RedeemerAddress: redeemerAddress,
+		IssuerAddress:   issuerAddress,
+		Amount:          amount,
+	}
+}
+func (msg MsgRedeemFiat) Route() string { return RouterKey }
+func (msg MsgRedeemFiat) Type() string  { return "redeemFiat" }
+func (msg MsgRedeemFiat) ValidateBasic() sdkTypes.Error {
+	if msg.RedeemerAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing redeemer address")
+	}
+	if msg.IssuerAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing issuer address")
+	}
+	if msg.Amount <= 0 {
+		return ErrInvalidAmount(DefaultCodespace, "redeem amount must be positive")
+	}
+	return nil
+}
+func (msg MsgRedeemFiat) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+func (msg MsgRedeemFiat) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.RedeemerAddress}
+}
+
+type MsgSendFiat struct {
+	FromAddress sdkTypes.AccAddress `json:"fromAddress"`
+	ToAddress   sdkTypes.AccAddress `json:"toAddress"`
+	Amount      int64               `json:"amount"`
+}
+
+var _ sdkTypes.Msg = MsgSendFiat{}
+
+func NewMsgSendFiat(fromAddress sdkTypes.AccAddress, toAddress sdkTypes.AccAddress, amount int64) MsgSendFiat {
+	return MsgSendFiat{
+		FromAddress: fromAddress,
+		ToAddress:   toAddress,
+		Amount:      amount,
+	}
+}
+func (msg MsgSendFiat) Route() string { return RouterKey }
+func (msg MsgSendFiat) Type() string  { return "sendFiat" }
+func (msg MsgSendFiat) ValidateBasic() sdkTypes.Error {
+	if msg.FromAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing sender address")
+	}
+	if msg.ToAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing recipient address")
+	}
+	if msg.Amount <= 0 {
+		return ErrInvalidAmount(DefaultCodespace, "send amount must be positive")
+	}
+	return nil
+}
+func (msg MsgSendFiat) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+func (msg MsgSendFiat) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.FromAddress}
+}
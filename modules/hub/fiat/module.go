@@ -0,0 +1,69 @@
+package fiat
+
+import (
+	"encoding/json"
+
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+const ModuleName = "fiat"
+
+var (
+	_ module.AppModuleBasic = AppModuleBasic{}
+	_ module.AppModule      = AppModule{}
+)
+
+type AppModuleBasic struct{}
+
+func (AppModuleBasic) Name() string                   { return ModuleName }
+func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) { RegisterCodec(cdc) }
+func (AppModuleBasic) DefaultGenesis() json.RawMessage {
+	return msgCdc.MustMarshalJSON(DefaultGenesisState())
+}
+func (AppModuleBasic) ValidateGenesis(data json.RawMessage) error {
+	var genesisState GenesisState
+	if err := msgCdc.UnmarshalJSON(data, &genesisState); err != nil {
+		return err
+	}
+	return ValidateGenesis(genesisState)
+}
+func (AppModuleBasic) RegisterRESTRoutes(_ context.CLIContext, _ *mux.Router) {}
+func (AppModuleBasic) GetTxCmd(_ *codec.Codec) *cobra.Command                 { return nil }
+func (AppModuleBasic) GetQueryCmd(_ *codec.Codec) *cobra.Command              { return nil }
+
+type AppModule struct {
+	AppModuleBasic
+	keeper Keeper
+}
+
+func NewAppModule(keeper Keeper) AppModule {
+	return AppModule{
+		AppModuleBasic: AppModuleBasic{},
+		keeper:         keeper,
+	}
+}
+func (AppModule) RegisterInvariants(_ sdkTypes.InvariantRegistry) {}
+func (AppModule) Route() string                                   { return RouterKey }
+func (appModule AppModule) NewHandler() sdkTypes.Handler          { return NewHandler(appModule.keeper) }
+func (AppModule) QuerierRoute() string                            { return QuerierRoute }
+func (appModule AppModule) NewQuerierHandler() sdkTypes.Querier   { return NewQuerier(appModule.keeper) }
+func (appModule AppModule) InitGenesis(ctx sdkTypes.Context, data json.RawMessage) []abciTypes.ValidatorUpdate {
+	var genesisState GenesisState
+	msgCdc.MustUnmarshalJSON(data, &genesisState)
+	InitGenesis(ctx, appModule.keeper, genesisState)
+	return []abciTypes.ValidatorUpdate{}
+}
+func (appModule AppModule) ExportGenesis(ctx sdkTypes.Context) json.RawMessage {
+	return msgCdc.MustMarshalJSON(ExportGenesis(ctx, appModule.keeper))
+}
+func (AppModule) BeginBlock(_ sdkTypes.Context, _ abciTypes.RequestBeginBlock) {}
+func (AppModule) EndBlock(_ sdkTypes.Context, _ abciTypes.RequestEndBlock) []abciTypes.ValidatorUpdate {
+	return []abciTypes.ValidatorUpdate{}
+}
@@ -0,0 +1,80 @@
+package fiat
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/transfer"
+)
+
+var _ transfer.PegHandler = Keeper{}
+
+func (keeper Keeper) OnSendPacket(ctx sdkTypes.Context, packet transfer.Packet) (transfer.Packet, sdkTypes.Error) {
+	if err := keeper.SendFiat(ctx, packet.SenderAddress, transfer.GetEscrowAddress(packet.DestinationChainID), packet.FiatAmount); err != nil {
+		return transfer.Packet{}, err
+	}
+
+	zoneEscrow := keeper.GetZoneEscrow(ctx, packet.DestinationChainID)
+	zoneEscrow.PendingAmount += packet.FiatAmount
+	keeper.SetZoneEscrow(ctx, zoneEscrow)
+	return packet, nil
+}
+func (keeper Keeper) OnReceivePacket(ctx sdkTypes.Context, packet transfer.Packet) sdkTypes.Error {
+	zoneEscrow := keeper.GetZoneEscrow(ctx, packet.SourceChainID)
+	escrowAddress := transfer.GetEscrowAddress(packet.SourceChainID)
+
+	releasedAmount := keeper.GetFiatPegWallet(ctx, escrowAddress).AmountOf(escrowAddress) - zoneEscrow.PendingAmount
+	if releasedAmount > packet.FiatAmount {
+		releasedAmount = packet.FiatAmount
+	}
+	if releasedAmount < 0 {
+		releasedAmount = 0
+	}
+	if releasedAmount > 0 {
+		if err := keeper.SendFiat(ctx, escrowAddress, packet.ReceiverAddress, releasedAmount); err != nil {
+			return err
+		}
+	}
+
+	if mintedAmount := packet.FiatAmount - releasedAmount; mintedAmount > 0 {
+		transactionID := fmt.Sprintf("%s/%d", packet.SourceChainID, packet.Sequence)
+		if _, err := keeper.mintFiat(ctx, transfer.GetChainAddress(packet.SourceChainID), packet.ReceiverAddress, transactionID, mintedAmount); err != nil {
+			return err
+		}
+	}
+
+	zoneEscrow.EscrowedAmount += packet.FiatAmount
+	keeper.SetZoneEscrow(ctx, zoneEscrow)
+	return nil
+}
+func (keeper Keeper) OnAcknowledgePacket(ctx sdkTypes.Context, packet transfer.Packet, success bool) sdkTypes.Error {
+	if !success {
+		return keeper.refundPacket(ctx, packet)
+	}
+
+	// Fiat that came from the zone unwinds first; only the rest was hub fiat
+	// leaving for the zone.
+	zoneEscrow := keeper.GetZoneEscrow(ctx, packet.DestinationChainID)
+	zoneEscrow.PendingAmount -= packet.FiatAmount
+	if packet.FiatAmount > zoneEscrow.EscrowedAmount {
+		zoneEscrow.EscrowedAmount = 0
+	} else {
+		zoneEscrow.EscrowedAmount -= packet.FiatAmount
+	}
+	keeper.SetZoneEscrow(ctx, zoneEscrow)
+	return nil
+}
+func (keeper Keeper) OnTimeoutPacket(ctx sdkTypes.Context, packet transfer.Packet) sdkTypes.Error {
+	return keeper.refundPacket(ctx, packet)
+}
+func (keeper Keeper) refundPacket(ctx sdkTypes.Context, packet transfer.Packet) sdkTypes.Error {
+	if err := keeper.SendFiat(ctx, transfer.GetEscrowAddress(packet.DestinationChainID), packet.SenderAddress, packet.FiatAmount); err != nil {
+		return err
+	}
+
+	zoneEscrow := keeper.GetZoneEscrow(ctx, packet.DestinationChainID)
+	zoneEscrow.PendingAmount -= packet.FiatAmount
+	keeper.SetZoneEscrow(ctx, zoneEscrow)
+	return nil
+}
@@ -0,0 +1,63 @@
+package fiat
+
+import (
+	"fmt"
+	"strings"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+const DefaultParamspace = "fiat"
+
+var ParamStoreKeyParams = []byte("params")
+
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable(
+		ParamStoreKeyParams, Params{},
+	)
+}
+
+type Params struct {
+	Issuers []sdkTypes.AccAddress `json:"issuers"`
+}
+
+func NewParams(issuers []sdkTypes.AccAddress) Params {
+	return Params{
+		Issuers: issuers,
+	}
+}
+func DefaultParams() Params {
+	return Params{
+		Issuers: []sdkTypes.AccAddress{},
+	}
+}
+func (params Params) IsIssuer(address sdkTypes.AccAddress) bool {
+	for _, issuer := range params.Issuers {
+		if issuer.Equals(address) {
+			return true
+		}
+	}
+	return false
+}
+func (params Params) Validate() error {
+	issuerMap := make(map[string]bool, len(params.Issuers))
+	for _, issuer := range params.Issuers {
+		if issuer.Empty() {
+			return fmt.Errorf("fiat issuer address cannot be empty")
+		}
+		if issuerMap[issuer.String()] {
+			return fmt.Errorf("duplicate fiat issuer %s", issuer)
+		}
+		issuerMap[issuer.String()] = true
+	}
+	return nil
+}
+func (params Params) String() string {
+	issuers := make([]string, len(params.Issuers))
+	for i, issuer := range params.Issuers {
+		issuers[i] = issuer.String()
+	}
+	return fmt.Sprintf(`Fiat Params:
+  Issuers: %s`, strings.Join(issuers, ", "))
+}
@@ -1 +1,94 @@
-package asset
+package fiat
+
+import (
+	"fmt"
+
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+const (
+	QueryFiat       = "fiat"
+	QueryOwnerFiats = "ownerFiats"
+	QueryParams     = "params"
+)
+
+type QueryFiatParams struct {
+	PegHash types.PegHash `json:"pegHash"`
+}
+
+func NewQueryFiatParams(pegHash types.PegHash) QueryFiatParams {
+	return QueryFiatParams{
+		PegHash: pegHash,
+	}
+}
+
+type QueryOwnerFiatsParams struct {
+	OwnerAddress sdkTypes.AccAddress `json:"ownerAddress"`
+}
+
+func NewQueryOwnerFiatsParams(ownerAddress sdkTypes.AccAddress) QueryOwnerFiatsParams {
+	return QueryOwnerFiatsParams{
+		OwnerAddress: ownerAddress,
+	}
+}
+
+func NewQuerier(keeper Keeper) sdkTypes.Querier {
+	return func(ctx sdkTypes.Context, path []string, req abciTypes.RequestQuery) ([]byte, sdkTypes.Error) {
+		switch path[0] {
+		case QueryFiat:
+			return queryFiat(ctx, req, keeper)
+		case QueryOwnerFiats:
+			return queryOwnerFiats(ctx, req, keeper)
+		case QueryParams:
+			return queryParams(ctx, keeper)
+		default:
+			return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("unknown fiat query endpoint: %s", path[0]))
+		}
+	}
+}
+func queryFiat(ctx sdkTypes.Context, req abciTypes.RequestQuery, keeper Keeper) ([]byte, sdkTypes.Error) {
+	var params QueryFiatParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkTypes.ErrUnknownRequest(sdkTypes.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	fiatPeg, found := keeper.GetFiatPeg(ctx, params.PegHash)
+	if !found {
+		return nil, ErrFiatNotFound(keeper.codespace, params.PegHash)
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, fiatPeg)
+	if err != nil {
+		return nil, sdkTypes.ErrInternal(sdkTypes.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+	return res, nil
+}
+func queryOwnerFiats(ctx sdkTypes.Context, req abciTypes.RequestQuery, keeper Keeper) ([]byte, sdkTypes.Error) {
+	var params QueryOwnerFiatsParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkTypes.ErrUnknownRequest(sdkTypes.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	fiatPegWallet := keeper.GetFiatPegWallet(ctx, params.OwnerAddress)
+	if fiatPegWallet == nil {
+		fiatPegWallet = types.FiatPegWallet{}
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, fiatPegWallet)
+	if err != nil {
+		return nil, sdkTypes.ErrInternal(sdkTypes.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+	return res, nil
+}
+func queryParams(ctx sdkTypes.Context, keeper Keeper) ([]byte, sdkTypes.Error) {
+	res, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetParams(ctx))
+	if err != nil {
+		return nil, sdkTypes.ErrInternal(sdkTypes.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+	return res, nil
+}
@@ -0,0 +1,36 @@
+package fiat
+
+import (
+	"fmt"
+)
+
+type ZoneEscrow struct {
+	ChainID        string `json:"chainID"`
+	EscrowedAmount int64  `json:"escrowedAmount"`
+	PendingAmount  int64  `json:"pendingAmount"`
+}
+
+func NewZoneEscrow(chainID string, escrowedAmount int64, pendingAmount int64) ZoneEscrow {
+	return ZoneEscrow{
+		ChainID:        chainID,
+		EscrowedAmount: escrowedAmount,
+		PendingAmount:  pendingAmount,
+	}
+}
+func (zoneEscrow ZoneEscrow) Validate() error {
+	if len(zoneEscrow.ChainID) == 0 {
+		return fmt.Errorf("zone escrow chain id cannot be empty")
+	}
+	if zoneEscrow.EscrowedAmount < 0 || zoneEscrow.PendingAmount < 0 {
+		return fmt.Errorf("zone escrow amounts of %s cannot be negative", zoneEscrow.ChainID)
+	}
+	return nil
+}
+func (zoneEscrow ZoneEscrow) String() string {
+	return fmt.Sprintf(`ZoneEscrow:
+  ChainID:        %s
+  EscrowedAmount: %d
+  PendingAmount:  %d`,
+		zoneEscrow.ChainID, zoneEscrow.EscrowedAmount, zoneEscrow.PendingAmount,
+	)
+}
@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/hub/reputation"
+)
+
+const flagLimit = "limit"
+
+func GetQueryCommand(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	command := &cobra.Command{
+		Use:                        reputation.QuerierRoute,
+		Short:                      "Querying commands for reputations",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	command.AddCommand(client.GetCommands(
+		QueryReputationCommand(queryRoute, cdc),
+		QueryTopReputationCommand(queryRoute, cdc),
+	)...)
+	return command
+}
+func QueryReputationCommand(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reputation [address]",
+		Short: "Query the reputation record and score of an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			address, err := sdkTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bytes, err := cdc.MarshalJSON(reputation.NewQueryReputationParams(address))
+			if err != nil {
+				return err
+			}
+			res, _, err := cliContext.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, reputation.QueryReputation), bytes)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+func QueryTopReputationCommand(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "top",
+		Short: "Query the highest scored reputations",
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			bytes, err := cdc.MarshalJSON(reputation.NewQueryTopReputationParams(viper.GetInt(flagLimit)))
+			if err != nil {
+				return err
+			}
+			res, _, err := cliContext.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, reputation.QueryTopReputation), bytes)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	command.Flags().Int(flagLimit, reputation.DefaultQueryLimit, "number of reputations to return")
+	return command
+}
@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/commitHub/commitBlockchain/modules/hub/reputation"
+)
+
+func GetTxCommand(cdc *codec.Codec) *cobra.Command {
+	command := &cobra.Command{
+		Use:                        reputation.RouterKey,
+		Short:                      "Reputation transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	command.AddCommand(client.PostCommands(
+		SubmitFeedbackCommand(cdc),
+	)...)
+	return command
+}
+func SubmitFeedbackCommand(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "feedback [to-address] [order-id] [rating]",
+		Short: "Rate the counterparty of a completed order",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(command *cobra.Command, args []string) error {
+			transactionBuilder := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			toAddress, err := sdkTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			rating, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := reputation.NewMsgSubmitFeedback(cliContext.GetFromAddress(), toAddress, args[1], rating)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliContext, transactionBuilder, []sdkTypes.Msg{msg})
+		},
+	}
+}
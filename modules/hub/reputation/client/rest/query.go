@@ -0,0 +1,40 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/commitHub/commitBlockchain/modules/hub/reputation"
+	restTypes "github.com/commitHub/commitBlockchain/types/rest"
+)
+
+func registerQueryRoutes(cliContext context.CLIContext, router *mux.Router) {
+	router.HandleFunc("/reputation/reputations/{address}", queryReputationHandlerFunction(cliContext)).Methods("GET")
+	router.HandleFunc("/reputation/top", queryTopReputationHandlerFunction(cliContext)).Methods("GET")
+}
+func queryReputationHandlerFunction(cliContext context.CLIContext) http.HandlerFunc {
+	return func(responseWriter http.ResponseWriter, request *http.Request) {
+		address, err := sdkTypes.AccAddressFromBech32(mux.Vars(request)["address"])
+		if err != nil {
+			rest.WriteErrorResponse(responseWriter, http.StatusBadRequest, err.Error())
+			return
+		}
+		restTypes.QueryWithParams(responseWriter, request, cliContext, fmt.Sprintf("custom/%s/%s", reputation.QuerierRoute, reputation.QueryReputation), reputation.NewQueryReputationParams(address))
+	}
+}
+func queryTopReputationHandlerFunction(cliContext context.CLIContext) http.HandlerFunc {
+	return func(responseWriter http.ResponseWriter, request *http.Request) {
+		limit, err := restTypes.ParseIntOrDefault(request.FormValue("limit"), reputation.DefaultQueryLimit)
+		if err != nil {
+			rest.WriteErrorResponse(responseWriter, http.StatusBadRequest, err.Error())
+			return
+		}
+		restTypes.QueryWithParams(responseWriter, request, cliContext, fmt.Sprintf("custom/%s/%s", reputation.QuerierRoute, reputation.QueryTopReputation), reputation.NewQueryTopReputationParams(limit))
+	}
+}
@@ -0,0 +1,37 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/commitHub/commitBlockchain/modules/hub/reputation"
+	restTypes "github.com/commitHub/commitBlockchain/types/rest"
+)
+
+type SubmitFeedbackRequest struct {
+	BaseRequest rest.BaseReq        `json:"base_req"`
+	ToAddress   sdkTypes.AccAddress `json:"toAddress"`
+	OrderID     string              `json:"orderID"`
+	Rating      int64               `json:"rating"`
+}
+
+func registerTxRoutes(cliContext context.CLIContext, router *mux.Router) {
+	router.HandleFunc("/reputation/feedback", submitFeedbackHandlerFunction(cliContext)).Methods("POST")
+}
+func submitFeedbackHandlerFunction(cliContext context.CLIContext) http.HandlerFunc {
+	return func(responseWriter http.ResponseWriter, request *http.Request) {
+		var submitFeedbackRequest SubmitFeedbackRequest
+		fromAddress, ok := restTypes.ReadBaseRequest(responseWriter, request, cliContext, &submitFeedbackRequest, &submitFeedbackRequest.BaseRequest)
+		if !ok {
+			return
+		}
+
+		msg := reputation.NewMsgSubmitFeedback(fromAddress, submitFeedbackRequest.ToAddress, submitFeedbackRequest.OrderID, submitFeedbackRequest.Rating)
+		restTypes.WriteGenerateStdTxResponse(responseWriter, cliContext, submitFeedbackRequest.BaseRequest, msg)
+	}
+}
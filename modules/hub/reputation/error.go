@@ -1 +1,37 @@
-package asset
+package reputation
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdkTypes.CodespaceType = "reputation"
+
+	CodeRecordNotFound  sdkTypes.CodeType = 101
+	CodeNotCounterparty sdkTypes.CodeType = 102
+	CodeTradeNotSettled sdkTypes.CodeType = 103
+	CodeFeedbackExists  sdkTypes.CodeType = 104
+	CodeInvalidRating   sdkTypes.CodeType = 105
+	CodeInvalidOrderID  sdkTypes.CodeType = 106
+)
+
+func ErrRecordNotFound(codespace sdkTypes.CodespaceType, address sdkTypes.AccAddress) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeRecordNotFound, fmt.Sprintf("reputation record for %s not found", address))
+}
+func ErrNotCounterparty(codespace sdkTypes.CodespaceType, fromAddress sdkTypes.AccAddress, toAddress sdkTypes.AccAddress, orderID string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeNotCounterparty, fmt.Sprintf("%s and %s are not counterparties in order %s", fromAddress, toAddress, orderID))
+}
+func ErrTradeNotSettled(codespace sdkTypes.CodespaceType, orderID string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeTradeNotSettled, fmt.Sprintf("order %s has not been settled", orderID))
+}
+func ErrFeedbackExists(codespace sdkTypes.CodespaceType, fromAddress sdkTypes.AccAddress, orderID string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeFeedbackExists, fmt.Sprintf("%s has already given feedback for order %s", fromAddress, orderID))
+}
+func ErrInvalidRating(codespace sdkTypes.CodespaceType, rating int64) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeInvalidRating, fmt.Sprintf("rating %d must be between %d and %d", rating, MinRating, MaxRating))
+}
+func ErrInvalidOrderID(codespace sdkTypes.CodespaceType) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeInvalidOrderID, "order id cannot be empty")
+}
@@ -0,0 +1,8 @@
+package reputation
+
+var (
+	AttributeKeyFrom    = "from"
+	AttributeKeyTo      = "to"
+	AttributeKeyOrderID = "orderID"
+	AttributeKeyRating  = "rating"
+)
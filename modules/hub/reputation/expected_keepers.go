@@ -0,0 +1,16 @@
+package reputation
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/hub/contract"
+	"github.com/commitHub/commitBlockchain/modules/hub/escrow"
+)
+
+type EscrowKeeper interface {
+	GetEscrow(ctx sdkTypes.Context, orderID string) (escrow.Escrow, bool)
+}
+
+type ContractKeeper interface {
+	GetNegotiation(ctx sdkTypes.Context, negotiationID string) (contract.Negotiation, bool)
+}
@@ -1 +1,98 @@
-package asset
+package reputation
+
+import (
+	"fmt"
+	"strings"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+type GenesisTrade struct {
+	Address sdkTypes.AccAddress `json:"address"`
+	Trade   Trade               `json:"trade"`
+}
+
+func NewGenesisTrade(address sdkTypes.AccAddress, trade Trade) GenesisTrade {
+	return GenesisTrade{
+		Address: address,
+		Trade:   trade,
+	}
+}
+
+type GenesisState struct {
+	Records   []Record       `json:"records"`
+	Trades    []GenesisTrade `json:"trades"`
+	Feedbacks []Feedback     `json:"feedbacks"`
+}
+
+func NewGenesisState(records []Record, trades []GenesisTrade, feedbacks []Feedback) GenesisState {
+	return GenesisState{
+		Records:   records,
+		Trades:    trades,
+		Feedbacks: feedbacks,
+	}
+}
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState([]Record{}, []GenesisTrade{}, []Feedback{})
+}
+func InitGenesis(ctx sdkTypes.Context, keeper Keeper, genesisState GenesisState) {
+	for _, record := range genesisState.Records {
+		keeper.SetRecord(ctx, record)
+	}
+	for _, genesisTrade := range genesisState.Trades {
+		keeper.SetTrade(ctx, genesisTrade.Address, genesisTrade.Trade)
+	}
+	for _, feedback := range genesisState.Feedbacks {
+		keeper.SetFeedback(ctx, feedback)
+	}
+}
+func ExportGenesis(ctx sdkTypes.Context, keeper Keeper) GenesisState {
+	var records []Record
+	var trades []GenesisTrade
+	var feedbacks []Feedback
+	keeper.IterateRecords(ctx, func(record Record) (stop bool) {
+		records = append(records, record)
+		for _, trade := range keeper.GetTrades(ctx, record.Address) {
+			trades = append(trades, NewGenesisTrade(record.Address, trade))
+		}
+		feedbacks = append(feedbacks, keeper.GetFeedbacks(ctx, record.Address)...)
+		return false
+	})
+	return NewGenesisState(records, trades, feedbacks)
+}
+func ValidateGenesis(genesisState GenesisState) error {
+	seenAddresses := make(map[string]bool)
+	for _, record := range genesisState.Records {
+		if record.Address.Empty() {
+			return fmt.Errorf("reputation record has an empty address")
+		}
+		if seenAddresses[record.Address.String()] {
+			return fmt.Errorf("duplicate reputation record for %s", record.Address)
+		}
+		seenAddresses[record.Address.String()] = true
+
+		if record.CompletedTrades < 0 || record.FailedTrades < 0 || record.FeedbackCount < 0 || record.RatingSum < 0 {
+			return fmt.Errorf("reputation record for %s has negative counts", record.Address)
+		}
+	}
+	for _, genesisTrade := range genesisState.Trades {
+		if !seenAddresses[genesisTrade.Address.String()] {
+			return fmt.Errorf("trade %s belongs to %s which has no reputation record", genesisTrade.Trade.OrderID, genesisTrade.Address)
+		}
+		if len(strings.TrimSpace(genesisTrade.Trade.OrderID)) == 0 {
+			return fmt.Errorf("trade for %s has an empty order id", genesisTrade.Address)
+		}
+	}
+	for _, feedback := range genesisState.Feedbacks {
+		if feedback.FromAddress.Empty() {
+			return fmt.Errorf("feedback for order %s has an empty sender address", feedback.OrderID)
+		}
+		if !seenAddresses[feedback.ToAddress.String()] {
+			return fmt.Errorf("feedback for order %s targets %s which has no reputation record", feedback.OrderID, feedback.ToAddress)
+		}
+		if feedback.Rating < MinRating || feedback.Rating > MaxRating {
+			return fmt.Errorf("feedback for order %s has an invalid rating %d", feedback.OrderID, feedback.Rating)
+		}
+	}
+	return nil
+}
@@ -1 +1,38 @@
-package asset
+package reputation
+
+import (
+	"fmt"
+	"strconv"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewHandler(keeper Keeper) sdkTypes.Handler {
+	return func(ctx sdkTypes.Context, msg sdkTypes.Msg) sdkTypes.Result {
+		switch msg := msg.(type) {
+		case MsgSubmitFeedback:
+			return handleMsgSubmitFeedback(ctx, keeper, msg)
+		default:
+			errMsg := fmt.Sprintf("unrecognized reputation message type: %T", msg)
+			return sdkTypes.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+func handleMsgSubmitFeedback(ctx sdkTypes.Context, keeper Keeper, msg MsgSubmitFeedback) sdkTypes.Result {
+	if err := keeper.SubmitFeedback(ctx, msg.FromAddress, msg.ToAddress, msg.OrderID, msg.Rating); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			msg.Type(),
+			sdkTypes.NewAttribute(AttributeKeyFrom, msg.FromAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyTo, msg.ToAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyOrderID, msg.OrderID),
+			sdkTypes.NewAttribute(AttributeKeyRating, strconv.FormatInt(msg.Rating, 10)),
+		),
+	)
+	return sdkTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
@@ -0,0 +1,47 @@
+package reputation
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/hub/contract"
+	"github.com/commitHub/commitBlockchain/modules/hub/escrow"
+)
+
+type Hooks struct {
+	keeper Keeper
+}
+
+var _ escrow.EscrowHooks = Hooks{}
+
+func (keeper Keeper) Hooks() Hooks {
+	return Hooks{keeper}
+}
+func (hooks Hooks) isSignedContract(ctx sdkTypes.Context, tradeEscrow escrow.Escrow) bool {
+	negotiation, found := hooks.keeper.contractKeeper.GetNegotiation(ctx, tradeEscrow.OrderID)
+	if !found || negotiation.Status != contract.StatusAccepted {
+		return false
+	}
+	if len(negotiation.BuyerSignature) == 0 || len(negotiation.SellerSignature) == 0 {
+		return false
+	}
+	terms := negotiation.Terms
+	return tradeEscrow.MatchesTerms(terms.BuyerAddress, terms.SellerAddress, terms.PegHash, terms.Bid, terms.Time)
+}
+func (hooks Hooks) AfterEscrowSettled(ctx sdkTypes.Context, settledEscrow escrow.Escrow) {
+	if !hooks.isSignedContract(ctx, settledEscrow) {
+		return
+	}
+	hooks.keeper.RecordTrade(ctx, settledEscrow.BuyerAddress, settledEscrow.OrderID, true)
+	hooks.keeper.RecordTrade(ctx, settledEscrow.SellerAddress, settledEscrow.OrderID, true)
+}
+func (hooks Hooks) AfterEscrowRefunded(ctx sdkTypes.Context, refundedEscrow escrow.Escrow) {
+	if !hooks.isSignedContract(ctx, refundedEscrow) {
+		return
+	}
+	if !refundedEscrow.FiatLocked {
+		hooks.keeper.RecordTrade(ctx, refundedEscrow.BuyerAddress, refundedEscrow.OrderID, false)
+	}
+	if !refundedEscrow.AssetLocked {
+		hooks.keeper.RecordTrade(ctx, refundedEscrow.SellerAddress, refundedEscrow.OrderID, false)
+	}
+}
@@ -1 +1,171 @@
-package asset
+package reputation
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/hub/escrow"
+)
+
+const (
+	StoreKey     = "reputation"
+	QuerierRoute = "reputation"
+)
+
+var (
+	RecordKeyPrefix   = []byte{0x01}
+	TradeKeyPrefix    = []byte{0x02}
+	FeedbackKeyPrefix = []byte{0x03}
+)
+
+func GetRecordKey(address sdkTypes.AccAddress) []byte {
+	return append(RecordKeyPrefix, address.Bytes()...)
+}
+func GetTradesKey(address sdkTypes.AccAddress) []byte {
+	return append(TradeKeyPrefix, address.Bytes()...)
+}
+func GetTradeKey(address sdkTypes.AccAddress, orderID string) []byte {
+	return append(GetTradesKey(address), []byte(orderID)...)
+}
+func GetFeedbacksKey(toAddress sdkTypes.AccAddress) []byte {
+	return append(FeedbackKeyPrefix, toAddress.Bytes()...)
+}
+func GetFeedbackKey(toAddress sdkTypes.AccAddress, fromAddress sdkTypes.AccAddress, orderID string) []byte {
+	return append(append(GetFeedbacksKey(toAddress), fromAddress.Bytes()...), []byte(orderID)...)
+}
+
+type Keeper struct {
+	storeKey       sdkTypes.StoreKey
+	cdc            *codec.Codec
+	escrowKeeper   EscrowKeeper
+	contractKeeper ContractKeeper
+	codespace      sdkTypes.CodespaceType
+}
+
+func NewKeeper(cdc *codec.Codec, storeKey sdkTypes.StoreKey, escrowKeeper EscrowKeeper, contractKeeper ContractKeeper, codespace sdkTypes.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:       storeKey,
+		cdc:            cdc,
+		escrowKeeper:   escrowKeeper,
+		contractKeeper: contractKeeper,
+		codespace:      codespace,
+	}
+}
+func (keeper Keeper) Codespace() sdkTypes.CodespaceType {
+	return keeper.codespace
+}
+func (keeper Keeper) GetRecord(ctx sdkTypes.Context, address sdkTypes.AccAddress) (Record, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	recordBytes := store.Get(GetRecordKey(address))
+	if recordBytes == nil {
+		return Record{}, false
+	}
+
+	var record Record
+	keeper.cdc.MustUnmarshalBinaryBare(recordBytes, &record)
+	return record, true
+}
+func (keeper Keeper) getOrNewRecord(ctx sdkTypes.Context, address sdkTypes.AccAddress) Record {
+	if record, found := keeper.GetRecord(ctx, address); found {
+		return record
+	}
+	return NewRecord(address)
+}
+func (keeper Keeper) SetRecord(ctx sdkTypes.Context, record Record) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(GetRecordKey(record.Address), keeper.cdc.MustMarshalBinaryBare(record))
+}
+func (keeper Keeper) IterateRecords(ctx sdkTypes.Context, handler func(record Record) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdkTypes.KVStorePrefixIterator(store, RecordKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record Record
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+		if handler(record) {
+			break
+		}
+	}
+}
+func (keeper Keeper) SetTrade(ctx sdkTypes.Context, address sdkTypes.AccAddress, trade Trade) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(GetTradeKey(address, trade.OrderID), keeper.cdc.MustMarshalBinaryBare(trade))
+}
+func (keeper Keeper) hasTrade(ctx sdkTypes.Context, address sdkTypes.AccAddress, orderID string) bool {
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Has(GetTradeKey(address, orderID))
+}
+func (keeper Keeper) GetTrades(ctx sdkTypes.Context, address sdkTypes.AccAddress) []Trade {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdkTypes.KVStorePrefixIterator(store, GetTradesKey(address))
+	defer iterator.Close()
+
+	var trades []Trade
+	for ; iterator.Valid(); iterator.Next() {
+		var trade Trade
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &trade)
+		trades = append(trades, trade)
+	}
+	return trades
+}
+func (keeper Keeper) GetFeedback(ctx sdkTypes.Context, toAddress sdkTypes.AccAddress, fromAddress sdkTypes.AccAddress, orderID string) (Feedback, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	feedbackBytes := store.Get(GetFeedbackKey(toAddress, fromAddress, orderID))
+	if feedbackBytes == nil {
+		return Feedback{}, false
+	}
+
+	var feedback Feedback
+	keeper.cdc.MustUnmarshalBinaryBare(feedbackBytes, &feedback)
+	return feedback, true
+}
+func (keeper Keeper) SetFeedback(ctx sdkTypes.Context, feedback Feedback) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(GetFeedbackKey(feedback.ToAddress, feedback.FromAddress, feedback.OrderID), keeper.cdc.MustMarshalBinaryBare(feedback))
+}
+func (keeper Keeper) GetFeedbacks(ctx sdkTypes.Context, toAddress sdkTypes.AccAddress) []Feedback {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdkTypes.KVStorePrefixIterator(store, GetFeedbacksKey(toAddress))
+	defer iterator.Close()
+
+	var feedbacks []Feedback
+	for ; iterator.Valid(); iterator.Next() {
+		var feedback Feedback
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &feedback)
+		feedbacks = append(feedbacks, feedback)
+	}
+	return feedbacks
+}
+func (keeper Keeper) RecordTrade(ctx sdkTypes.Context, address sdkTypes.AccAddress, orderID string, completed bool) {
+	record := keeper.getOrNewRecord(ctx, address)
+	if completed {
+		record.CompletedTrades++
+	} else {
+		record.FailedTrades++
+	}
+
+	keeper.SetRecord(ctx, record)
+	keeper.SetTrade(ctx, address, NewTrade(orderID, completed, ctx.BlockHeader().Time))
+}
+func (keeper Keeper) SubmitFeedback(ctx sdkTypes.Context, fromAddress sdkTypes.AccAddress, toAddress sdkTypes.AccAddress, orderID string, rating int64) sdkTypes.Error {
+	settledEscrow, found := keeper.escrowKeeper.GetEscrow(ctx, orderID)
+	if !found || settledEscrow.Status != escrow.StatusSettled || !keeper.hasTrade(ctx, toAddress, orderID) {
+		return ErrTradeNotSettled(keeper.codespace, orderID)
+	}
+	if !(settledEscrow.BuyerAddress.Equals(fromAddress) && settledEscrow.SellerAddress.Equals(toAddress)) &&
+		!(settledEscrow.SellerAddress.Equals(fromAddress) && settledEscrow.BuyerAddress.Equals(toAddress)) {
+		return ErrNotCounterparty(keeper.codespace, fromAddress, toAddress, orderID)
+	}
+	if _, found := keeper.GetFeedback(ctx, toAddress, fromAddress, orderID); found {
+		return ErrFeedbackExists(keeper.codespace, fromAddress, orderID)
+	}
+
+	record := keeper.getOrNewRecord(ctx, toAddress)
+	record.FeedbackCount++
+	record.RatingSum += rating
+
+	keeper.SetRecord(ctx, record)
+	keeper.SetFeedback(ctx, NewFeedback(fromAddress, toAddress, orderID, rating, ctx.BlockHeader().Time))
+	return nil
+}
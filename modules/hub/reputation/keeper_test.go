@@ -0,0 +1,144 @@
+package reputation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/commitHub/commitBlockchain/modules/hub/contract"
+	"github.com/commitHub/commitBlockchain/modules/hub/escrow"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+type testEscrowKeeper struct {
+	escrows map[string]escrow.Escrow
+}
+
+func (escrowKeeper testEscrowKeeper) GetEscrow(ctx sdkTypes.Context, orderID string) (escrow.Escrow, bool) {
+	escrow, found := escrowKeeper.escrows[orderID]
+	return escrow, found
+}
+
+type testContractKeeper struct{}
+
+func (testContractKeeper) GetNegotiation(ctx sdkTypes.Context, negotiationID string) (contract.Negotiation, bool) {
+	return contract.Negotiation{}, false
+}
+
+func newTestContext(t *testing.T, storeKey sdkTypes.StoreKey, blockTime time.Time) sdkTypes.Context {
+	multiStore := store.NewCommitMultiStore(dbm.NewMemDB())
+	multiStore.MountStoreWithDB(storeKey, sdkTypes.StoreTypeIAVL, nil)
+	if err := multiStore.LoadLatestVersion(); err != nil {
+		t.Fatal(err)
+	}
+	return sdkTypes.NewContext(multiStore, abci.Header{Height: 1, Time: blockTime}, false, log.NewNopLogger())
+}
+
+func newTestAddress() sdkTypes.AccAddress {
+	return sdkTypes.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+}
+
+func TestDecayWeightBoundaries(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name      string
+		eventTime time.Time
+		weight    sdkTypes.Dec
+	}{
+		{"future event", now.Add(time.Hour), sdkTypes.OneDec()},
+		{"same time", now, sdkTypes.OneDec()},
+		{"just inside the first period", now.Add(-DecayPeriod + time.Nanosecond), sdkTypes.OneDec()},
+		{"one full period", now.Add(-DecayPeriod), sdkTypes.NewDecWithPrec(5, 1)},
+		{"two full periods", now.Add(-2 * DecayPeriod), sdkTypes.NewDecWithPrec(25, 2)},
+		{"last decaying period", now.Add(-(MaxDecayPeriods - 1) * DecayPeriod), sdkTypes.OneDec().QuoInt64(int64(1) << (MaxDecayPeriods - 1))},
+		{"max decay periods", now.Add(-MaxDecayPeriods * DecayPeriod), sdkTypes.ZeroDec()},
+		{"beyond max decay periods", now.Add(-2 * MaxDecayPeriods * DecayPeriod), sdkTypes.ZeroDec()},
+	}
+	for _, testCase := range testCases {
+		if weight := decayWeight(now, testCase.eventTime); !weight.Equal(testCase.weight) {
+			t.Errorf("%s: expected weight %s, got %s", testCase.name, testCase.weight, weight)
+		}
+	}
+}
+
+func TestScoreDecaysOldTrades(t *testing.T) {
+	storeKey := sdkTypes.NewKVStoreKey(StoreKey)
+	keeper := NewKeeper(codec.New(), storeKey, testEscrowKeeper{}, testContractKeeper{}, DefaultCodespace)
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := newTestContext(t, storeKey, start)
+	address := newTestAddress()
+
+	keeper.RecordTrade(ctx, address, "failed", false)
+	ctx = ctx.WithBlockTime(start.Add(DecayPeriod))
+	keeper.RecordTrade(ctx, address, "completed", true)
+
+	// The failed trade is one period old and weighs half the completed one.
+	expected := sdkTypes.OneDec().Quo(sdkTypes.NewDecWithPrec(15, 1)).Mul(TradeScoreWeight)
+	if score := keeper.GetScore(ctx, address); !score.Equal(expected) {
+		t.Fatalf("expected score %s, got %s", expected, score)
+	}
+
+	ctx = ctx.WithBlockTime(start.Add(MaxDecayPeriods * DecayPeriod))
+	if score := keeper.GetScore(ctx, address); !score.Equal(TradeScoreWeight) {
+		t.Fatalf("expected the fully decayed failed trade to be ignored, got score %s", score)
+	}
+
+	ctx = ctx.WithBlockTime(start.Add((MaxDecayPeriods + 1) * DecayPeriod))
+	if score := keeper.GetScore(ctx, address); !score.IsZero() {
+		t.Fatalf("expected fully decayed trades to leave no score, got %s", score)
+	}
+}
+
+func TestSubmitFeedbackRequiresSettledEscrow(t *testing.T) {
+	storeKey := sdkTypes.NewKVStoreKey(StoreKey)
+	escrowKeeper := testEscrowKeeper{escrows: make(map[string]escrow.Escrow)}
+	keeper := NewKeeper(codec.New(), storeKey, escrowKeeper, testContractKeeper{}, DefaultCodespace)
+	ctx := newTestContext(t, storeKey, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	buyerAddress, sellerAddress := newTestAddress(), newTestAddress()
+
+	if err := keeper.SubmitFeedback(ctx, buyerAddress, sellerAddress, "order", 5); err == nil || err.Code() != CodeTradeNotSettled {
+		t.Fatalf("expected feedback without an escrow to fail with %d, got %v", CodeTradeNotSettled, err)
+	}
+
+	openEscrow := escrow.NewEscrow("order", buyerAddress, sellerAddress, types.PegHash("peg"), 100, 10)
+	escrowKeeper.escrows["order"] = openEscrow
+	keeper.RecordTrade(ctx, sellerAddress, "order", true)
+	if err := keeper.SubmitFeedback(ctx, buyerAddress, sellerAddress, "order", 5); err == nil || err.Code() != CodeTradeNotSettled {
+		t.Fatalf("expected feedback on an open escrow to fail with %d, got %v", CodeTradeNotSettled, err)
+	}
+
+	refundedEscrow := openEscrow
+	refundedEscrow.Status = escrow.StatusRefunded
+	escrowKeeper.escrows["order"] = refundedEscrow
+	if err := keeper.SubmitFeedback(ctx, buyerAddress, sellerAddress, "order", 5); err == nil || err.Code() != CodeTradeNotSettled {
+		t.Fatalf("expected feedback on a refunded escrow to fail with %d, got %v", CodeTradeNotSettled, err)
+	}
+
+	settledEscrow := openEscrow
+	settledEscrow.Status = escrow.StatusSettled
+	escrowKeeper.escrows["order"] = settledEscrow
+	if err := keeper.SubmitFeedback(ctx, sellerAddress, buyerAddress, "order", 5); err == nil || err.Code() != CodeTradeNotSettled {
+		t.Fatalf("expected feedback on a party with no recorded trade to fail with %d, got %v", CodeTradeNotSettled, err)
+	}
+	if err := keeper.SubmitFeedback(ctx, newTestAddress(), sellerAddress, "order", 5); err == nil || err.Code() != CodeNotCounterparty {
+		t.Fatalf("expected feedback from an outsider to fail with %d, got %v", CodeNotCounterparty, err)
+	}
+	if err := keeper.SubmitFeedback(ctx, buyerAddress, sellerAddress, "order", 4); err != nil {
+		t.Fatalf("expected feedback on a settled escrow to succeed, got %s", err.Error())
+	}
+	if err := keeper.SubmitFeedback(ctx, buyerAddress, sellerAddress, "order", 4); err == nil || err.Code() != CodeFeedbackExists {
+		t.Fatalf("expected repeated feedback to fail with %d, got %v", CodeFeedbackExists, err)
+	}
+
+	record, _ := keeper.GetRecord(ctx, sellerAddress)
+	if record.FeedbackCount != 1 || record.RatingSum != 4 {
+		t.Fatalf("expected one rating of 4, got %d ratings summing to %d", record.FeedbackCount, record.RatingSum)
+	}
+}
@@ -1 +1,53 @@
-package asset
+package reputation
+
+import (
+	"strings"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+const RouterKey = "reputation"
+
+type MsgSubmitFeedback struct {
+	FromAddress sdkTypes.AccAddress `json:"fromAddress"`
+	ToAddress   sdkTypes.AccAddress `json:"toAddress"`
+	OrderID     string              `json:"orderID"`
+	Rating      int64               `json:"rating"`
+}
+
+var _ sdkTypes.Msg = MsgSubmitFeedback{}
+
+func NewMsgSubmitFeedback(fromAddress sdkTypes.AccAddress, toAddress sdkTypes.AccAddress, orderID string, rating int64) MsgSubmitFeedback {
+	return MsgSubmitFeedback{
+		FromAddress: fromAddress,
+		ToAddress:   toAddress,
+		OrderID:     orderID,
+		Rating:      rating,
+	}
+}
+func (msg MsgSubmitFeedback) Route() string { return RouterKey }
+func (msg MsgSubmitFeedback) Type() string  { return "submitFeedback" }
+func (msg MsgSubmitFeedback) ValidateBasic() sdkTypes.Error {
+	if msg.FromAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing sender address")
+	}
+	if msg.ToAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing recipient address")
+	}
+	if msg.FromAddress.Equals(msg.ToAddress) {
+		return sdkTypes.ErrInvalidAddress("cannot give feedback to yourself")
+	}
+	if len(strings.TrimSpace(msg.OrderID)) == 0 {
+		return ErrInvalidOrderID(DefaultCodespace)
+	}
+	if msg.Rating < MinRating || msg.Rating > MaxRating {
+		return ErrInvalidRating(DefaultCodespace, msg.Rating)
+	}
+	return nil
+}
+func (msg MsgSubmitFeedback) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+func (msg MsgSubmitFeedback) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.FromAddress}
+}
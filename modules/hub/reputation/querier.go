@@ -1 +1,112 @@
-package asset
+package reputation
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	QueryReputation    = "reputation"
+	QueryTopReputation = "top"
+
+	DefaultQueryLimit = 100
+)
+
+type QueryReputationParams struct {
+	Address sdkTypes.AccAddress `json:"address"`
+}
+
+func NewQueryReputationParams(address sdkTypes.AccAddress) QueryReputationParams {
+	return QueryReputationParams{
+		Address: address,
+	}
+}
+
+type QueryTopReputationParams struct {
+	Limit int `json:"limit"`
+}
+
+func NewQueryTopReputationParams(limit int) QueryTopReputationParams {
+	return QueryTopReputationParams{
+		Limit: limit,
+	}
+}
+
+type Reputation struct {
+	Record Record       `json:"record"`
+	Score  sdkTypes.Dec `json:"score"`
+}
+
+func NewReputation(record Record, score sdkTypes.Dec) Reputation {
+	return Reputation{
+		Record: record,
+		Score:  score,
+	}
+}
+
+func NewQuerier(keeper Keeper) sdkTypes.Querier {
+	return func(ctx sdkTypes.Context, path []string, req abciTypes.RequestQuery) ([]byte, sdkTypes.Error) {
+		switch path[0] {
+		case QueryReputation:
+			return queryReputation(ctx, req, keeper)
+		case QueryTopReputation:
+			return queryTopReputation(ctx, req, keeper)
+		default:
+			return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("unknown reputation query endpoint: %s", path[0]))
+		}
+	}
+}
+func queryReputation(ctx sdkTypes.Context, req abciTypes.RequestQuery, keeper Keeper) ([]byte, sdkTypes.Error) {
+	var params QueryReputationParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkTypes.ErrUnknownRequest(sdkTypes.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	record, found := keeper.GetRecord(ctx, params.Address)
+	if !found {
+		return nil, ErrRecordNotFound(keeper.codespace, params.Address)
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, NewReputation(record, keeper.GetScore(ctx, params.Address)))
+	if err != nil {
+		return nil, sdkTypes.ErrInternal(sdkTypes.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+	return res, nil
+}
+func queryTopReputation(ctx sdkTypes.Context, req abciTypes.RequestQuery, keeper Keeper) ([]byte, sdkTypes.Error) {
+	var params QueryTopReputationParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkTypes.ErrUnknownRequest(sdkTypes.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	if params.Limit < 1 || params.Limit > DefaultQueryLimit {
+		params.Limit = DefaultQueryLimit
+	}
+
+	reputations := []Reputation{}
+	keeper.IterateRecords(ctx, func(record Record) (stop bool) {
+		reputations = append(reputations, NewReputation(record, keeper.GetScore(ctx, record.Address)))
+		return false
+	})
+	sort.SliceStable(reputations, func(i, j int) bool {
+		if !reputations[i].Score.Equal(reputations[j].Score) {
+			return reputations[i].Score.GT(reputations[j].Score)
+		}
+		return bytes.Compare(reputations[i].Record.Address, reputations[j].Record.Address) < 0
+	})
+	if len(reputations) > params.Limit {
+		reputations = reputations[:params.Limit]
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, reputations)
+	if err != nil {
+		return nil, sdkTypes.ErrInternal(sdkTypes.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+	return res, nil
+}
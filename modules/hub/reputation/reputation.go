@@ -0,0 +1,69 @@
+package reputation
+
+import (
+	"fmt"
+	"time"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	MinRating int64 = 1
+	MaxRating int64 = 5
+)
+
+type Record struct {
+	Address         sdkTypes.AccAddress `json:"address"`
+	CompletedTrades int64               `json:"completedTrades"`
+	FailedTrades    int64               `json:"failedTrades"`
+	FeedbackCount   int64               `json:"feedbackCount"`
+	RatingSum       int64               `json:"ratingSum"`
+}
+
+func NewRecord(address sdkTypes.AccAddress) Record {
+	return Record{
+		Address: address,
+	}
+}
+func (record Record) String() string {
+	return fmt.Sprintf(`Record:
+  Address:         %s
+  CompletedTrades: %d
+  FailedTrades:    %d
+  FeedbackCount:   %d
+  RatingSum:       %d`,
+		record.Address, record.CompletedTrades, record.FailedTrades, record.FeedbackCount, record.RatingSum,
+	)
+}
+
+type Trade struct {
+	OrderID   string    `json:"orderID"`
+	Completed bool      `json:"completed"`
+	Time      time.Time `json:"time"`
+}
+
+func NewTrade(orderID string, completed bool, time time.Time) Trade {
+	return Trade{
+		OrderID:   orderID,
+		Completed: completed,
+		Time:      time,
+	}
+}
+
+type Feedback struct {
+	FromAddress sdkTypes.AccAddress `json:"fromAddress"`
+	ToAddress   sdkTypes.AccAddress `json:"toAddress"`
+	OrderID     string              `json:"orderID"`
+	Rating      int64               `json:"rating"`
+	Time        time.Time           `json:"time"`
+}
+
+func NewFeedback(fromAddress sdkTypes.AccAddress, toAddress sdkTypes.AccAddress, orderID string, rating int64, time time.Time) Feedback {
+	return Feedback{
+		FromAddress: fromAddress,
+		ToAddress:   toAddress,
+		OrderID:     orderID,
+		Rating:      rating,
+		Time:        time,
+	}
+}
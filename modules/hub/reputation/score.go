@@ -0,0 +1,57 @@
+package reputation
+
+import (
+	"time"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DecayPeriod     = 30 * 24 * time.Hour
+	MaxDecayPeriods = 60
+)
+
+var (
+	TradeScoreWeight  = sdkTypes.NewDecWithPrec(5, 1)
+	RatingScoreWeight = sdkTypes.NewDecWithPrec(5, 1)
+)
+
+func decayWeight(now time.Time, eventTime time.Time) sdkTypes.Dec {
+	if !eventTime.Before(now) {
+		return sdkTypes.OneDec()
+	}
+
+	periods := int64(now.Sub(eventTime) / DecayPeriod)
+	if periods >= MaxDecayPeriods {
+		return sdkTypes.ZeroDec()
+	}
+	return sdkTypes.OneDec().QuoInt64(int64(1) << uint64(periods))
+}
+func (keeper Keeper) GetScore(ctx sdkTypes.Context, address sdkTypes.AccAddress) sdkTypes.Dec {
+	now := ctx.BlockHeader().Time
+
+	completedWeight, tradeWeight := sdkTypes.ZeroDec(), sdkTypes.ZeroDec()
+	for _, trade := range keeper.GetTrades(ctx, address) {
+		weight := decayWeight(now, trade.Time)
+		tradeWeight = tradeWeight.Add(weight)
+		if trade.Completed {
+			completedWeight = completedWeight.Add(weight)
+		}
+	}
+
+	ratingWeight, feedbackWeight := sdkTypes.ZeroDec(), sdkTypes.ZeroDec()
+	for _, feedback := range keeper.GetFeedbacks(ctx, address) {
+		weight := decayWeight(now, feedback.Time)
+		feedbackWeight = feedbackWeight.Add(weight)
+		ratingWeight = ratingWeight.Add(weight.MulInt64(feedback.Rating))
+	}
+
+	score := sdkTypes.ZeroDec()
+	if tradeWeight.IsPositive() {
+		score = score.Add(completedWeight.Quo(tradeWeight).Mul(TradeScoreWeight))
+	}
+	if feedbackWeight.IsPositive() {
+		score = score.Add(ratingWeight.Quo(feedbackWeight).QuoInt64(MaxRating).Mul(RatingScoreWeight))
+	}
+	return score
+}
@@ -0,0 +1,17 @@
+package zone
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgRegisterZone{}, "commit/zone/MsgRegisterZone", nil)
+	cdc.RegisterConcrete(MsgUpdateZoneHeader{}, "commit/zone/MsgUpdateZoneHeader", nil)
+}
+
+var msgCdc = codec.New()
+
+func init() {
+	RegisterCodec(msgCdc)
+	codec.RegisterCrypto(msgCdc)
+}
@@ -0,0 +1,45 @@
+package zone
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdkTypes.CodespaceType = "zone"
+
+	CodeZoneNotFound          sdkTypes.CodeType = 101
+	CodeZoneExists            sdkTypes.CodeType = 102
+	CodeInvalidChainID        sdkTypes.CodeType = 103
+	CodeInvalidHeader         sdkTypes.CodeType = 104
+	CodeInvalidValidators     sdkTypes.CodeType = 105
+	CodeInvalidCommit         sdkTypes.CodeType = 106
+	CodeStaleHeader           sdkTypes.CodeType = 107
+	CodeUnauthorizedRegistrar sdkTypes.CodeType = 108
+)
+
+func ErrZoneNotFound(codespace sdkTypes.CodespaceType, chainID string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeZoneNotFound, fmt.Sprintf("zone %s is not registered", chainID))
+}
+func ErrZoneExists(codespace sdkTypes.CodespaceType, chainID string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeZoneExists, fmt.Sprintf("zone %s is already registered", chainID))
+}
+func ErrInvalidChainID(codespace sdkTypes.CodespaceType, message string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeInvalidChainID, message)
+}
+func ErrInvalidHeader(codespace sdkTypes.CodespaceType, message string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeInvalidHeader, message)
+}
+func ErrInvalidValidators(codespace sdkTypes.CodespaceType, message string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeInvalidValidators, message)
+}
+func ErrInvalidCommit(codespace sdkTypes.CodespaceType, message string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeInvalidCommit, message)
+}
+func ErrStaleHeader(codespace sdkTypes.CodespaceType, height int64, latestHeight int64) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeStaleHeader, fmt.Sprintf("header height %d is not above latest trusted height %d", height, latestHeight))
+}
+func ErrUnauthorizedRegistrar(codespace sdkTypes.CodespaceType, address sdkTypes.AccAddress) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeUnauthorizedRegistrar, fmt.Sprintf("%s is not a zone registrar", address))
+}
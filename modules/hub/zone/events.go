@@ -0,0 +1,8 @@
+package zone
+
+var (
+	AttributeKeyChainID  = "chainID"
+	AttributeKeyOperator = "operator"
+	AttributeKeyRelayer  = "relayer"
+	AttributeKeyHeight   = "height"
+)
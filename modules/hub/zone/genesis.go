@@ -0,0 +1,75 @@
+package zone
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+type GenesisZone struct {
+	Zone    Zone   `json:"zone"`
+	AppHash []byte `json:"appHash"`
+}
+
+func NewGenesisZone(zone Zone, appHash []byte) GenesisZone {
+	return GenesisZone{
+		Zone:    zone,
+		AppHash: appHash,
+	}
+}
+
+type GenesisState struct {
+	Params Params        `json:"params"`
+	Zones  []GenesisZone `json:"zones"`
+}
+
+func NewGenesisState(params Params, zones []GenesisZone) GenesisState {
+	return GenesisState{
+		Params: params,
+		Zones:  zones,
+	}
+}
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), []GenesisZone{})
+}
+func InitGenesis(ctx sdkTypes.Context, keeper Keeper, genesisState GenesisState) {
+	keeper.SetParams(ctx, genesisState.Params)
+	for _, genesisZone := range genesisState.Zones {
+		keeper.SetZone(ctx, genesisZone.Zone)
+		keeper.setAppHash(ctx, genesisZone.Zone.ChainID, genesisZone.Zone.LatestHeight, genesisZone.AppHash)
+	}
+}
+func ExportGenesis(ctx sdkTypes.Context, keeper Keeper) GenesisState {
+	var zones []GenesisZone
+	keeper.IterateZones(ctx, func(zone Zone) (stop bool) {
+		appHash, _ := keeper.GetAppHash(ctx, zone.ChainID, zone.LatestHeight)
+		zones = append(zones, NewGenesisZone(zone, appHash))
+		return false
+	})
+	return NewGenesisState(keeper.GetParams(ctx), zones)
+}
+func ValidateGenesis(genesisState GenesisState) error {
+	if err := genesisState.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenChainIDs := make(map[string]bool)
+	for _, genesisZone := range genesisState.Zones {
+		zone := genesisZone.Zone
+		if len(zone.ChainID) == 0 {
+			return fmt.Errorf("zone chain id cannot be empty")
+		}
+		if seenChainIDs[zone.ChainID] {
+			return fmt.Errorf("duplicate zone %s", zone.ChainID)
+		}
+		seenChainIDs[zone.ChainID] = true
+
+		if len(zone.Validators) == 0 {
+			return fmt.Errorf("zone %s has an empty validator set", zone.ChainID)
+		}
+		if zone.LatestHeight <= 0 || len(genesisZone.AppHash) == 0 {
+			return fmt.Errorf("zone %s has no trusted app hash", zone.ChainID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package zone
+
+import (
+	"fmt"
+	"strconv"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewHandler(keeper Keeper) sdkTypes.Handler {
+	return func(ctx sdkTypes.Context, msg sdkTypes.Msg) sdkTypes.Result {
+		switch msg := msg.(type) {
+		case MsgRegisterZone:
+			return handleMsgRegisterZone(ctx, keeper, msg)
+		case MsgUpdateZoneHeader:
+			return handleMsgUpdateZoneHeader(ctx, keeper, msg)
+		default:
+			errMsg := fmt.Sprintf("unrecognized zone message type: %T", msg)
+			return sdkTypes.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+func handleMsgRegisterZone(ctx sdkTypes.Context, keeper Keeper, msg MsgRegisterZone) sdkTypes.Result {
+	zone, err := keeper.RegisterZone(ctx, msg.OperatorAddress, msg.ChainID, msg.SignedHeader, msg.Validators)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			msg.Type(),
+			sdkTypes.NewAttribute(AttributeKeyChainID, zone.ChainID),
+			sdkTypes.NewAttribute(AttributeKeyOperator, zone.OperatorAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyHeight, strconv.FormatInt(zone.LatestHeight, 10)),
+		),
+	)
+	return sdkTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+func handleMsgUpdateZoneHeader(ctx sdkTypes.Context, keeper Keeper, msg MsgUpdateZoneHeader) sdkTypes.Result {
+	zone, err := keeper.UpdateZoneHeader(ctx, msg.ChainID, msg.SignedHeader, msg.Validators)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			msg.Type(),
+			sdkTypes.NewAttribute(AttributeKeyChainID, zone.ChainID),
+			sdkTypes.NewAttribute(AttributeKeyRelayer, msg.RelayerAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyHeight, strconv.FormatInt(zone.LatestHeight, 10)),
+		),
+	)
+	return sdkTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
@@ -0,0 +1,168 @@
+package zone
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	tendermintTypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+const (
+	StoreKey     = "zone"
+	QuerierRoute = "zone"
+)
+
+var (
+	ZoneKeyPrefix    = []byte{0x01}
+	AppHashKeyPrefix = []byte{0x02}
+)
+
+func GetZoneKey(chainID string) []byte {
+	return append(ZoneKeyPrefix, []byte(chainID)...)
+}
+func GetAppHashKey(chainID string, height int64) []byte {
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, uint64(height))
+	return append(append(append(AppHashKeyPrefix, []byte(chainID)...), '/'), heightBytes...)
+}
+
+type Keeper struct {
+	storeKey   sdkTypes.StoreKey
+	cdc        *codec.Codec
+	paramSpace params.Subspace
+	codespace  sdkTypes.CodespaceType
+}
+
+func NewKeeper(cdc *codec.Codec, storeKey sdkTypes.StoreKey, paramSpace params.Subspace, codespace sdkTypes.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:   storeKey,
+		cdc:        cdc,
+		paramSpace: paramSpace.WithKeyTable(ParamKeyTable()),
+		codespace:  codespace,
+	}
+}
+func (keeper Keeper) Codespace() sdkTypes.CodespaceType {
+	return keeper.codespace
+}
+func (keeper Keeper) GetParams(ctx sdkTypes.Context) Params {
+	params := DefaultParams()
+	keeper.paramSpace.GetIfExists(ctx, ParamStoreKeyParams, &params)
+	return params
+}
+func (keeper Keeper) SetParams(ctx sdkTypes.Context, params Params) {
+	keeper.paramSpace.Set(ctx, ParamStoreKeyParams, &params)
+}
+func (keeper Keeper) GetZone(ctx sdkTypes.Context, chainID string) (Zone, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	zoneBytes := store.Get(GetZoneKey(chainID))
+	if zoneBytes == nil {
+		return Zone{}, false
+	}
+
+	var zone Zone
+	keeper.cdc.MustUnmarshalBinaryBare(zoneBytes, &zone)
+	return zone, true
+}
+func (keeper Keeper) SetZone(ctx sdkTypes.Context, zone Zone) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(GetZoneKey(zone.ChainID), keeper.cdc.MustMarshalBinaryBare(zone))
+}
+func (keeper Keeper) IterateZones(ctx sdkTypes.Context, handler func(zone Zone) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdkTypes.KVStorePrefixIterator(store, ZoneKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var zone Zone
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &zone)
+		if handler(zone) {
+			break
+		}
+	}
+}
+func (keeper Keeper) GetLatestHeight(ctx sdkTypes.Context, chainID string) (int64, bool) {
+	zone, found := keeper.GetZone(ctx, chainID)
+	return zone.LatestHeight, found
+}
+func (keeper Keeper) GetAppHash(ctx sdkTypes.Context, chainID string, height int64) ([]byte, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	appHash := store.Get(GetAppHashKey(chainID, height))
+	return appHash, appHash != nil
+}
+func (keeper Keeper) setAppHash(ctx sdkTypes.Context, chainID string, height int64, appHash []byte) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(GetAppHashKey(chainID, height), appHash)
+}
+func (keeper Keeper) verifyHeader(chainID string, signedHeader tendermintTypes.SignedHeader, validators []*tendermintTypes.Validator) (*tendermintTypes.ValidatorSet, sdkTypes.Error) {
+	if signedHeader.Header == nil || signedHeader.Commit == nil {
+		return nil, ErrInvalidHeader(keeper.codespace, "header and commit cannot be empty")
+	}
+	if err := signedHeader.ValidateBasic(chainID); err != nil {
+		return nil, ErrInvalidHeader(keeper.codespace, err.Error())
+	}
+	if len(signedHeader.AppHash) == 0 {
+		return nil, ErrInvalidHeader(keeper.codespace, "header app hash cannot be empty")
+	}
+	if len(validators) == 0 {
+		return nil, ErrInvalidValidators(keeper.codespace, "validator set cannot be empty")
+	}
+
+	validatorSet := tendermintTypes.NewValidatorSet(validators)
+	if !bytes.Equal(validatorSet.Hash(), signedHeader.ValidatorsHash) {
+		return nil, ErrInvalidValidators(keeper.codespace, "validator set does not match the header validators hash")
+	}
+	if err := validatorSet.VerifyCommit(chainID, signedHeader.Commit.BlockID, signedHeader.Height, signedHeader.Commit); err != nil {
+		return nil, ErrInvalidCommit(keeper.codespace, err.Error())
+	}
+	return validatorSet, nil
+}
+func (keeper Keeper) RegisterZone(ctx sdkTypes.Context, operatorAddress sdkTypes.AccAddress, chainID string, signedHeader tendermintTypes.SignedHeader, validators []*tendermintTypes.Validator) (Zone, sdkTypes.Error) {
+	if !keeper.GetParams(ctx).IsRegistrar(operatorAddress) {
+		return Zone{}, ErrUnauthorizedRegistrar(keeper.codespace, operatorAddress)
+	}
+	if chainID == ctx.ChainID() {
+		return Zone{}, ErrInvalidChainID(keeper.codespace, "cannot register the local chain as a zone")
+	}
+	if _, found := keeper.GetZone(ctx, chainID); found {
+		return Zone{}, ErrZoneExists(keeper.codespace, chainID)
+	}
+	if _, err := keeper.verifyHeader(chainID, signedHeader, validators); err != nil {
+		return Zone{}, err
+	}
+
+	zone := NewZone(chainID, operatorAddress, validators, signedHeader.Height)
+	keeper.SetZone(ctx, zone)
+	keeper.setAppHash(ctx, chainID, signedHeader.Height, signedHeader.AppHash)
+	return zone, nil
+}
+func (keeper Keeper) UpdateZoneHeader(ctx sdkTypes.Context, chainID string, signedHeader tendermintTypes.SignedHeader, validators []*tendermintTypes.Validator) (Zone, sdkTypes.Error) {
+	zone, found := keeper.GetZone(ctx, chainID)
+	if !found {
+		return Zone{}, ErrZoneNotFound(keeper.codespace, chainID)
+	}
+
+	validatorSet, err := keeper.verifyHeader(chainID, signedHeader, validators)
+	if err != nil {
+		return Zone{}, err
+	}
+	if signedHeader.Height <= zone.LatestHeight {
+		return Zone{}, ErrStaleHeader(keeper.codespace, signedHeader.Height, zone.LatestHeight)
+	}
+
+	trustedValidatorSet := zone.GetValidatorSet()
+	if !bytes.Equal(trustedValidatorSet.Hash(), validatorSet.Hash()) {
+		if err := trustedValidatorSet.VerifyFutureCommit(validatorSet, chainID, signedHeader.Commit.BlockID, signedHeader.Height, signedHeader.Commit); err != nil {
+			return Zone{}, ErrInvalidCommit(keeper.codespace, err.Error())
+		}
+	}
+
+	zone.Validators = validators
+	zone.LatestHeight = signedHeader.Height
+	keeper.SetZone(ctx, zone)
+	keeper.setAppHash(ctx, chainID, signedHeader.Height, signedHeader.AppHash)
+	return zone, nil
+}
@@ -0,0 +1,86 @@
+package zone
+
+import (
+	"strings"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	tendermintTypes "github.com/tendermint/tendermint/types"
+)
+
+const RouterKey = "zone"
+
+type MsgRegisterZone struct {
+	OperatorAddress sdkTypes.AccAddress          `json:"operatorAddress"`
+	ChainID         string                       `json:"chainID"`
+	SignedHeader    tendermintTypes.SignedHeader `json:"signedHeader"`
+	Validators      []*tendermintTypes.Validator `json:"validators"`
+}
+
+var _ sdkTypes.Msg = MsgRegisterZone{}
+
+func NewMsgRegisterZone(operatorAddress sdkTypes.AccAddress, chainID string, signedHeader tendermintTypes.SignedHeader, validators []*tendermintTypes.Validator) MsgRegisterZone {
+	return MsgRegisterZone{
+		OperatorAddress: operatorAddress,
+		ChainID:         chainID,
+		SignedHeader:    signedHeader,
+		Validators:      validators,
+	}
+}
+func (msg MsgRegisterZone) Route() string { return RouterKey }
+func (msg MsgRegisterZone) Type() string  { return "registerZone" }
+func (msg MsgRegisterZone) ValidateBasic() sdkTypes.Error {
+	if msg.OperatorAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing operator address")
+	}
+	return validateHeader(msg.ChainID, msg.SignedHeader, msg.Validators)
+}
+func (msg MsgRegisterZone) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+func (msg MsgRegisterZone) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.OperatorAddress}
+}
+
+type MsgUpdateZoneHeader struct {
+	RelayerAddress sdkTypes.AccAddress          `json:"relayerAddress"`
+	ChainID        string                       `json:"chainID"`
+	SignedHeader   tendermintTypes.SignedHeader `json:"signedHeader"`
+	Validators     []*tendermintTypes.Validator `json:"validators"`
+}
+
+var _ sdkTypes.Msg = MsgUpdateZoneHeader{}
+
+func NewMsgUpdateZoneHeader(relayerAddress sdkTypes.AccAddress, chainID string, signedHeader tendermintTypes.SignedHeader, validators []*tendermintTypes.Validator) MsgUpdateZoneHeader {
+	return MsgUpdateZoneHeader{
+		RelayerAddress: relayerAddress,
+		ChainID:        chainID,
+		SignedHeader:   signedHeader,
+		Validators:     validators,
+	}
+}
+func (msg MsgUpdateZoneHeader) Route() string { return RouterKey }
+func (msg MsgUpdateZoneHeader) Type() string  { return "updateZoneHeader" }
+func (msg MsgUpdateZoneHeader) ValidateBasic() sdkTypes.Error {
+	if msg.RelayerAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing relayer address")
+	}
+	return validateHeader(msg.ChainID, msg.SignedHeader, msg.Validators)
+}
+func (msg MsgUpdateZoneHeader) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+func (msg MsgUpdateZoneHeader) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.RelayerAddress}
+}
+func validateHeader(chainID string, signedHeader tendermintTypes.SignedHeader, validators []*tendermintTypes.Validator) sdkTypes.Error {
+	if len(strings.TrimSpace(chainID)) == 0 {
+		return ErrInvalidChainID(DefaultCodespace, "chain id cannot be empty")
+	}
+	if signedHeader.Header == nil || signedHeader.Commit == nil {
+		return ErrInvalidHeader(DefaultCodespace, "header and commit cannot be empty")
+	}
+	if len(validators) == 0 {
+		return ErrInvalidValidators(DefaultCodespace, "validator set cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package zone
+
+import (
+	"fmt"
+	"strings"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+const DefaultParamspace = "zone"
+
+var ParamStoreKeyParams = []byte("params")
+
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable(
+		ParamStoreKeyParams, Params{},
+	)
+}
+
+type Params struct {
+	Registrars []sdkTypes.AccAddress `json:"registrars"`
+}
+
+func NewParams(registrars []sdkTypes.AccAddress) Params {
+	return Params{
+		Registrars: registrars,
+	}
+}
+func DefaultParams() Params {
+	return Params{
+		Registrars: []sdkTypes.AccAddress{},
+	}
+}
+func (params Params) IsRegistrar(address sdkTypes.AccAddress) bool {
+	for _, registrar := range params.Registrars {
+		if registrar.Equals(address) {
+			return true
+		}
+	}
+	return false
+}
+func (params Params) Validate() error {
+	registrarMap := make(map[string]bool, len(params.Registrars))
+	for _, registrar := range params.Registrars {
+		if registrar.Empty() {
+			return fmt.Errorf("zone registrar address cannot be empty")
+		}
+		if registrarMap[registrar.String()] {
+			return fmt.Errorf("duplicate zone registrar %s", registrar)
+		}
+		registrarMap[registrar.String()] = true
+	}
+	return nil
+}
+func (params Params) String() string {
+	registrars := make([]string, len(params.Registrars))
+	for i, registrar := range params.Registrars {
+		registrars[i] = registrar.String()
+	}
+	return fmt.Sprintf(`Zone Params:
+  Registrars: %s`, strings.Join(registrars, ", "))
+}
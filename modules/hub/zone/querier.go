@@ -0,0 +1,68 @@
+package zone
+
+import (
+	"fmt"
+
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	QueryZone  = "zone"
+	QueryZones = "zones"
+)
+
+type QueryZoneParams struct {
+	ChainID string `json:"chainID"`
+}
+
+func NewQueryZoneParams(chainID string) QueryZoneParams {
+	return QueryZoneParams{
+		ChainID: chainID,
+	}
+}
+
+func NewQuerier(keeper Keeper) sdkTypes.Querier {
+	return func(ctx sdkTypes.Context, path []string, req abciTypes.RequestQuery) ([]byte, sdkTypes.Error) {
+		switch path[0] {
+		case QueryZone:
+			return queryZone(ctx, req, keeper)
+		case QueryZones:
+			return queryZones(ctx, keeper)
+		default:
+			return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("unknown zone query endpoint: %s", path[0]))
+		}
+	}
+}
+func queryZone(ctx sdkTypes.Context, req abciTypes.RequestQuery, keeper Keeper) ([]byte, sdkTypes.Error) {
+	var params QueryZoneParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkTypes.ErrUnknownRequest(sdkTypes.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	zone, found := keeper.GetZone(ctx, params.ChainID)
+	if !found {
+		return nil, ErrZoneNotFound(keeper.codespace, params.ChainID)
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, zone)
+	if err != nil {
+		return nil, sdkTypes.ErrInternal(sdkTypes.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+	return res, nil
+}
+func queryZones(ctx sdkTypes.Context, keeper Keeper) ([]byte, sdkTypes.Error) {
+	zones := []Zone{}
+	keeper.IterateZones(ctx, func(zone Zone) (stop bool) {
+		zones = append(zones, zone)
+		return false
+	})
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, zones)
+	if err != nil {
+		return nil, sdkTypes.ErrInternal(sdkTypes.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+	return res, nil
+}
@@ -0,0 +1,36 @@
+package zone
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	tendermintTypes "github.com/tendermint/tendermint/types"
+)
+
+type Zone struct {
+	ChainID         string                       `json:"chainID"`
+	OperatorAddress sdkTypes.AccAddress          `json:"operatorAddress"`
+	Validators      []*tendermintTypes.Validator `json:"validators"`
+	LatestHeight    int64                        `json:"latestHeight"`
+}
+
+func NewZone(chainID string, operatorAddress sdkTypes.AccAddress, validators []*tendermintTypes.Validator, latestHeight int64) Zone {
+	return Zone{
+		ChainID:         chainID,
+		OperatorAddress: operatorAddress,
+		Validators:      validators,
+		LatestHeight:    latestHeight,
+	}
+}
+func (zone Zone) GetValidatorSet() *tendermintTypes.ValidatorSet {
+	return tendermintTypes.NewValidatorSet(zone.Validators)
+}
+func (zone Zone) String() string {
+	return fmt.Sprintf(`Zone:
+  ChainID:         %s
+  OperatorAddress: %s
+  Validators:      %d
+  LatestHeight:    %d`,
+		zone.ChainID, zone.OperatorAddress, len(zone.Validators), zone.LatestHeight,
+	)
+}
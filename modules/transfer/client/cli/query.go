@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/commitHub/commitBlockchain/modules/transfer"
+)
+
+func GetQueryCommand(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	command := &cobra.Command{
+		Use:                        transfer.QuerierRoute,
+		Short:                      "Querying commands for peg transfer packets",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	command.AddCommand(client.GetCommands(
+		QueryPacketCommand(queryRoute, cdc),
+		QueryPacketsCommand(queryRoute, cdc),
+		QueryAcknowledgementCommand(queryRoute, cdc),
+		QuerySequenceCommand(queryRoute, cdc),
+	)...)
+	return command
+}
+func QueryPacketCommand(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "packet [destination-chain-id] [sequence]",
+		Short: "Query an outbound packet by its sequence",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(command *cobra.Command, args []string) error {
+			return queryPacket(cdc, fmt.Sprintf("custom/%s/%s", queryRoute, transfer.QueryPacket), args)
+		},
+	}
+}
+func QueryPacketsCommand(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "packets [destination-chain-id]",
+		Short: "Query the outbound packets still awaiting acknowledgement or timeout",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			bytes, err := cdc.MarshalJSON(transfer.NewQueryPacketsParams(args[0]))
+			if err != nil {
+				return err
+			}
+			res, _, err := cliContext.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, transfer.QueryPackets), bytes)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+func QueryAcknowledgementCommand(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "acknowledgement [source-chain-id] [sequence]",
+		Short: "Query the acknowledgement written for a received packet",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(command *cobra.Command, args []string) error {
+			return queryPacket(cdc, fmt.Sprintf("custom/%s/%s", queryRoute, transfer.QueryAcknowledgement), args)
+		},
+	}
+}
+func QuerySequenceCommand(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "sequence [destination-chain-id]",
+		Short: "Query the next outbound packet sequence towards a chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			bytes, err := cdc.MarshalJSON(transfer.NewQueryPacketsParams(args[0]))
+			if err != nil {
+				return err
+			}
+			res, _, err := cliContext.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, transfer.QuerySequence), bytes)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+func queryPacket(cdc *codec.Codec, path string, args []string) error {
+	cliContext := context.NewCLIContext().WithCodec(cdc)
+
+	sequence, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return err
+	}
+
+	bytes, err := cdc.MarshalJSON(transfer.NewQueryPacketParams(args[0], sequence))
+	if err != nil {
+		return err
+	}
+	res, _, err := cliContext.QueryWithData(path, bytes)
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(res))
+	return nil
+}
@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/commitHub/commitBlockchain/modules/transfer"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+const flagTimeoutHeight = "timeout-height"
+
+func GetTxCommand(cdc *codec.Codec) *cobra.Command {
+	command := &cobra.Command{
+		Use:                        transfer.RouterKey,
+		Short:                      "Peg transfer transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	command.AddCommand(client.PostCommands(
+		SendPegCommand(cdc),
+	)...)
+	return command
+}
+func SendPegCommand(cdc *codec.Codec) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "send-peg [receiver-address] [destination-chain-id] [asset|fiat] [peg-hash|amount]",
+		Short: "Send an asset peg or an amount of fiat to another chain",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(command *cobra.Command, args []string) error {
+			transactionBuilder := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			receiverAddress, err := sdkTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			pegType, err := transfer.PegTypeFromString(args[2])
+			if err != nil {
+				return err
+			}
+
+			var pegHash types.PegHash
+			var amount int64
+			if pegType == transfer.PegTypeAsset {
+				pegHash, err = types.GetPegHashHex(args[3])
+			} else {
+				amount, err = strconv.ParseInt(args[3], 10, 64)
+			}
+			if err != nil {
+				return err
+			}
+
+			msg := transfer.NewMsgSendPeg(cliContext.GetFromAddress(), receiverAddress, args[1], pegType, pegHash, amount, viper.GetInt64(flagTimeoutHeight))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliContext, transactionBuilder, []sdkTypes.Msg{msg})
+		},
+	}
+	command.Flags().Int64(flagTimeoutHeight, 0, "height on the destination chain after which the transfer times out")
+	_ = command.MarkFlagRequired(flagTimeoutHeight)
+	return command
+}
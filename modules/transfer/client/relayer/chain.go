@@ -0,0 +1,138 @@
+package relayer
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptoKeys "github.com/cosmos/cosmos-sdk/crypto/keys"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	rpcClient "github.com/tendermint/tendermint/rpc/client"
+	tendermintTypes "github.com/tendermint/tendermint/types"
+
+	"github.com/commitHub/commitBlockchain/modules/hub/zone"
+	"github.com/commitHub/commitBlockchain/modules/transfer"
+)
+
+type Chain struct {
+	ChainID string
+	Client  rpcClient.Client
+
+	cdc        *codec.Codec
+	keybase    cryptoKeys.Keybase
+	keyName    string
+	passphrase string
+	address    sdkTypes.AccAddress
+	gas        uint64
+	fees       sdkTypes.Coins
+}
+
+func NewChain(cdc *codec.Codec, chainID string, client rpcClient.Client, keybase cryptoKeys.Keybase, keyName string, passphrase string, gas uint64, fees sdkTypes.Coins) (Chain, error) {
+	info, err := keybase.Get(keyName)
+	if err != nil {
+		return Chain{}, err
+	}
+
+	return Chain{
+		ChainID:    chainID,
+		Client:     client,
+		cdc:        cdc,
+		keybase:    keybase,
+		keyName:    keyName,
+		passphrase: passphrase,
+		address:    info.GetAddress(),
+		gas:        gas,
+		fees:       fees,
+	}, nil
+}
+func (chain Chain) LatestHeight() (int64, error) {
+	status, err := chain.Client.Status()
+	if err != nil {
+		return 0, err
+	}
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+func (chain Chain) SignedHeader(height int64) (tendermintTypes.SignedHeader, []*tendermintTypes.Validator, error) {
+	commit, err := chain.Client.Commit(&height)
+	if err != nil {
+		return tendermintTypes.SignedHeader{}, nil, err
+	}
+	validators, err := chain.Client.Validators(&height)
+	if err != nil {
+		return tendermintTypes.SignedHeader{}, nil, err
+	}
+	return commit.SignedHeader, validators.Validators, nil
+}
+func (chain Chain) Query(path string, params interface{}, height int64) ([]byte, error) {
+	data, err := chain.cdc.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := chain.Client.ABCIQueryWithOptions(path, data, rpcClient.ABCIQueryOptions{Height: height})
+	if err != nil {
+		return nil, err
+	}
+	if !result.Response.IsOK() {
+		return nil, fmt.Errorf("query %s on %s failed: %s", path, chain.ChainID, result.Response.Log)
+	}
+	return result.Response.Value, nil
+}
+func (chain Chain) QueryProof(key []byte, height int64) ([]byte, error) {
+	path := fmt.Sprintf("/store/%s/key", transfer.StoreKey)
+	result, err := chain.Client.ABCIQueryWithOptions(path, key, rpcClient.ABCIQueryOptions{Height: height, Prove: true})
+	if err != nil {
+		return nil, err
+	}
+	if !result.Response.IsOK() {
+		return nil, fmt.Errorf("proof query on %s failed: %s", chain.ChainID, result.Response.Log)
+	}
+	if result.Response.Proof == nil {
+		return nil, fmt.Errorf("%s returned no proof for height %d", chain.ChainID, height)
+	}
+	return result.Response.Proof.Marshal()
+}
+func (chain Chain) Broadcast(msgs []sdkTypes.Msg) error {
+	res, err := chain.Query(fmt.Sprintf("custom/%s/%s", auth.QuerierRoute, auth.QueryAccount), auth.NewQueryAccountParams(chain.address), 0)
+	if err != nil {
+		return err
+	}
+
+	var account auth.Account
+	if err := chain.cdc.UnmarshalJSON(res, &account); err != nil {
+		return err
+	}
+
+	txBuilder := auth.NewTxBuilder(
+		auth.DefaultTxEncoder(chain.cdc), account.GetAccountNumber(), account.GetSequence(),
+		chain.gas, 1, false, chain.ChainID, "", chain.fees, nil,
+	).WithKeybase(chain.keybase)
+
+	txBytes, err := txBuilder.BuildAndSign(chain.keyName, chain.passphrase, msgs)
+	if err != nil {
+		return err
+	}
+
+	result, err := chain.Client.BroadcastTxCommit(txBytes)
+	if err != nil {
+		return err
+	}
+	if !result.CheckTx.IsOK() {
+		return fmt.Errorf("transaction rejected by %s: %s", chain.ChainID, result.CheckTx.Log)
+	}
+	if !result.DeliverTx.IsOK() {
+		return fmt.Errorf("transaction failed on %s: %s", chain.ChainID, result.DeliverTx.Log)
+	}
+	return nil
+}
+func RegisterChain(chain Chain, counterparty Chain) error {
+	height, err := counterparty.LatestHeight()
+	if err != nil {
+		return err
+	}
+	signedHeader, validators, err := counterparty.SignedHeader(height)
+	if err != nil {
+		return err
+	}
+	return chain.Broadcast([]sdkTypes.Msg{zone.NewMsgRegisterZone(chain.address, counterparty.ChainID, signedHeader, validators)})
+}
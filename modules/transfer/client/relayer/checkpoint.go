@@ -0,0 +1,56 @@
+package relayer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+type PathCheckpoint struct {
+	DeliveredSequence uint64 `json:"deliveredSequence"`
+	SourceHeight      int64  `json:"sourceHeight"`
+}
+
+type Checkpoint struct {
+	Paths map[string]PathCheckpoint `json:"paths"`
+}
+
+func GetPathKey(sourceChainID string, destinationChainID string) string {
+	return fmt.Sprintf("%s/%s", sourceChainID, destinationChainID)
+}
+func LoadCheckpoint(checkpointFile string) (Checkpoint, error) {
+	checkpoint := Checkpoint{Paths: make(map[string]PathCheckpoint)}
+
+	checkpointBytes, err := ioutil.ReadFile(checkpointFile)
+	if os.IsNotExist(err) {
+		return checkpoint, nil
+	}
+	if err != nil {
+		return Checkpoint{}, err
+	}
+
+	if err := json.Unmarshal(checkpointBytes, &checkpoint); err != nil {
+		return Checkpoint{}, fmt.Errorf("invalid checkpoint file %s: %s", checkpointFile, err.Error())
+	}
+	if checkpoint.Paths == nil {
+		checkpoint.Paths = make(map[string]PathCheckpoint)
+	}
+	return checkpoint, nil
+}
+func (checkpoint Checkpoint) Save(checkpointFile string) error {
+	checkpointBytes, err := json.MarshalIndent(checkpoint, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(checkpointFile), 0700); err != nil {
+		return err
+	}
+
+	temporaryFile := checkpointFile + ".tmp"
+	if err := ioutil.WriteFile(temporaryFile, checkpointBytes, 0600); err != nil {
+		return err
+	}
+	return os.Rename(temporaryFile, checkpointFile)
+}
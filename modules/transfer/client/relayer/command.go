@@ -0,0 +1,133 @@
+package relayer
+
+import (
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+	"github.com/tendermint/tendermint/libs/log"
+	rpcClient "github.com/tendermint/tendermint/rpc/client"
+)
+
+const (
+	flagHubNode      = "hub-node"
+	flagHubChainID   = "hub-chain-id"
+	flagZoneNode     = "zone-node"
+	flagZoneChainID  = "zone-chain-id"
+	flagCheckpoint   = "checkpoint"
+	flagPollInterval = "poll-interval"
+	flagGas          = "gas"
+)
+
+func RelayCommand(cdc *codec.Codec) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "relay",
+		Short: "Relay peg packets between a hub and a zone",
+		Long: `Poll the hub and zone nodes for outbound peg packets and submit receive,
+acknowledgement and timeout messages, with proofs, to the other chain.
+Progress is kept in a checkpoint file so the relayer can be restarted safely.`,
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			hub, zone, err := newChains(cdc)
+			if err != nil {
+				return err
+			}
+
+			checkpointFile := viper.GetString(flagCheckpoint)
+			if checkpointFile == "" {
+				checkpointFile = filepath.Join(viper.GetString(cli.HomeFlag), "relayer", GetPathKey(hub.ChainID, zone.ChainID)+".json")
+			}
+
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "relayer")
+			relayer, err := NewRelayer(cdc, hub, zone, checkpointFile, logger)
+			if err != nil {
+				return err
+			}
+
+			quit := make(chan struct{})
+			signals := make(chan os.Signal, 1)
+			signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+			go func() {
+				<-signals
+				close(quit)
+			}()
+
+			relayer.Run(viper.GetDuration(flagPollInterval), quit)
+			return nil
+		},
+	}
+
+	command.Flags().String(flagCheckpoint, "", "checkpoint file, defaults to <home>/relayer/<hub>/<zone>.json")
+	command.Flags().Duration(flagPollInterval, defaultPollInterval, "interval between polls of the two chains")
+	addChainFlags(command)
+	return command
+}
+func RegisterCommand(cdc *codec.Codec) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "register-chains",
+		Short: "Register a hub and a zone with each other",
+		Long: `Register the zone's light client on the hub and the hub's light client on the
+zone, trusting the latest header of each chain. The key must be a zone
+registrar on both chains.`,
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			hub, zone, err := newChains(cdc)
+			if err != nil {
+				return err
+			}
+			if err := RegisterChain(hub, zone); err != nil {
+				return err
+			}
+			return RegisterChain(zone, hub)
+		},
+	}
+
+	addChainFlags(command)
+	return command
+}
+func addChainFlags(command *cobra.Command) {
+	command.Flags().String(flagHubNode, "tcp://localhost:26657", "<host>:<port> of the hub node's RPC interface")
+	command.Flags().String(flagHubChainID, "", "chain id of the hub")
+	command.Flags().String(flagZoneNode, "tcp://localhost:36657", "<host>:<port> of the zone node's RPC interface")
+	command.Flags().String(flagZoneChainID, "", "chain id of the zone")
+	command.Flags().String(client.FlagFrom, "", "name of the key used to sign transactions on both chains")
+	command.Flags().String(client.FlagFees, "", "fees to pay along with each transaction")
+	command.Flags().Uint64(flagGas, client.DefaultGasLimit*4, "gas limit of each transaction")
+	_ = command.MarkFlagRequired(flagHubChainID)
+	_ = command.MarkFlagRequired(flagZoneChainID)
+	_ = command.MarkFlagRequired(client.FlagFrom)
+}
+func newChains(cdc *codec.Codec) (Chain, Chain, error) {
+	keybase, err := keys.NewKeyBaseFromHomeFlag()
+	if err != nil {
+		return Chain{}, Chain{}, err
+	}
+	keyName := viper.GetString(client.FlagFrom)
+	passphrase, err := keys.GetPassphrase(keyName)
+	if err != nil {
+		return Chain{}, Chain{}, err
+	}
+	fees, err := sdkTypes.ParseCoins(viper.GetString(client.FlagFees))
+	if err != nil {
+		return Chain{}, Chain{}, err
+	}
+	gas := uint64(viper.GetInt64(flagGas))
+
+	hub, err := NewChain(cdc, viper.GetString(flagHubChainID), rpcClient.NewHTTP(viper.GetString(flagHubNode), "/websocket"), keybase, keyName, passphrase, gas, fees)
+	if err != nil {
+		return Chain{}, Chain{}, err
+	}
+	zone, err := NewChain(cdc, viper.GetString(flagZoneChainID), rpcClient.NewHTTP(viper.GetString(flagZoneNode), "/websocket"), keybase, keyName, passphrase, gas, fees)
+	if err != nil {
+		return Chain{}, Chain{}, err
+	}
+	return hub, zone, nil
+}
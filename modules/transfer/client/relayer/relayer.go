@@ -0,0 +1,199 @@
+package relayer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+	rpcClient "github.com/tendermint/tendermint/rpc/client"
+
+	"github.com/commitHub/commitBlockchain/modules/hub/zone"
+	"github.com/commitHub/commitBlockchain/modules/transfer"
+)
+
+const defaultPollInterval = 5 * time.Second
+
+type Relayer struct {
+	cdc            *codec.Codec
+	hub            Chain
+	zone           Chain
+	checkpoint     Checkpoint
+	checkpointFile string
+	logger         log.Logger
+}
+
+func NewRelayer(cdc *codec.Codec, hub Chain, zone Chain, checkpointFile string, logger log.Logger) (*Relayer, error) {
+	checkpoint, err := LoadCheckpoint(checkpointFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Relayer{
+		cdc:            cdc,
+		hub:            hub,
+		zone:           zone,
+		checkpoint:     checkpoint,
+		checkpointFile: checkpointFile,
+		logger:         logger,
+	}, nil
+}
+func (relayer *Relayer) Run(pollInterval time.Duration, quit <-chan struct{}) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := relayer.RelayOnce(); err != nil {
+			relayer.logger.Error(err.Error())
+		}
+
+		select {
+		case <-quit:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+func (relayer *Relayer) RelayOnce() error {
+	if err := relayer.relayPath(relayer.hub, relayer.zone); err != nil {
+		return fmt.Errorf("relaying %s to %s: %s", relayer.hub.ChainID, relayer.zone.ChainID, err.Error())
+	}
+	if err := relayer.relayPath(relayer.zone, relayer.hub); err != nil {
+		return fmt.Errorf("relaying %s to %s: %s", relayer.zone.ChainID, relayer.hub.ChainID, err.Error())
+	}
+	return nil
+}
+func (relayer *Relayer) relayPath(source Chain, destination Chain) error {
+	sourceHeight, err := source.LatestHeight()
+	if err != nil {
+		return err
+	}
+	destinationHeight, err := destination.LatestHeight()
+	if err != nil {
+		return err
+	}
+	if sourceHeight < 2 || destinationHeight < 2 {
+		return nil
+	}
+
+	pathKey := GetPathKey(source.ChainID, destination.ChainID)
+	pathCheckpoint := relayer.checkpoint.Paths[pathKey]
+
+	var packets []transfer.Packet
+	res, err := source.Query(fmt.Sprintf("custom/%s/%s", transfer.QuerierRoute, transfer.QueryPackets), transfer.NewQueryPacketsParams(destination.ChainID), sourceHeight-1)
+	if err != nil {
+		return err
+	}
+	if err := relayer.cdc.UnmarshalJSON(res, &packets); err != nil {
+		return err
+	}
+
+	var destinationMsgs, sourceMsgs []sdkTypes.Msg
+	for _, packet := range packets {
+		acknowledgementKey := transfer.GetAcknowledgementKey(packet.SourceChainID, packet.Sequence)
+		acknowledgement, received, err := relayer.queryAcknowledgement(destination, packet, destinationHeight-1)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case received:
+			proof, err := destination.QueryProof(acknowledgementKey, destinationHeight-1)
+			if err != nil {
+				return err
+			}
+			sourceMsgs = append(sourceMsgs, transfer.NewMsgAcknowledgePeg(source.address, packet, acknowledgement, proof, destinationHeight))
+		case destinationHeight >= packet.TimeoutHeight:
+			proof, err := destination.QueryProof(acknowledgementKey, destinationHeight-1)
+			if err != nil {
+				return err
+			}
+			sourceMsgs = append(sourceMsgs, transfer.NewMsgTimeoutPeg(source.address, packet, proof, destinationHeight))
+		case packet.Sequence > pathCheckpoint.DeliveredSequence:
+			proof, err := source.QueryProof(transfer.GetPacketKey(packet.DestinationChainID, packet.Sequence), sourceHeight-1)
+			if err != nil {
+				return err
+			}
+			destinationMsgs = append(destinationMsgs, transfer.NewMsgReceivePeg(destination.address, packet, proof, sourceHeight))
+			pathCheckpoint.DeliveredSequence = packet.Sequence
+		}
+	}
+
+	if len(destinationMsgs) > 0 {
+		msgs, err := relayer.withHeaderUpdate(destination, source, sourceHeight, destinationMsgs)
+		if err != nil {
+			return err
+		}
+		if err := destination.Broadcast(msgs); err != nil {
+			return err
+		}
+		relayer.logger.Info(fmt.Sprintf("delivered %d packets from %s to %s", len(destinationMsgs), source.ChainID, destination.ChainID))
+	}
+
+	pathCheckpoint.SourceHeight = sourceHeight
+	relayer.checkpoint.Paths[pathKey] = pathCheckpoint
+	if err := relayer.checkpoint.Save(relayer.checkpointFile); err != nil {
+		return err
+	}
+
+	if len(sourceMsgs) > 0 {
+		msgs, err := relayer.withHeaderUpdate(source, destination, destinationHeight, sourceMsgs)
+		if err != nil {
+			return err
+		}
+		if err := source.Broadcast(msgs); err != nil {
+			return err
+		}
+		relayer.logger.Info(fmt.Sprintf("settled %d packets from %s to %s", len(sourceMsgs), source.ChainID, destination.ChainID))
+	}
+	return nil
+}
+func (relayer *Relayer) queryAcknowledgement(destination Chain, packet transfer.Packet, height int64) (transfer.Acknowledgement, bool, error) {
+	path := fmt.Sprintf("/store/%s/key", transfer.StoreKey)
+	key := transfer.GetAcknowledgementKey(packet.SourceChainID, packet.Sequence)
+	result, err := destination.Client.ABCIQueryWithOptions(path, key, rpcClient.ABCIQueryOptions{Height: height})
+	if err != nil {
+		return transfer.Acknowledgement{}, false, err
+	}
+	if len(result.Response.Value) == 0 {
+		return transfer.Acknowledgement{}, false, nil
+	}
+
+	var acknowledgement transfer.Acknowledgement
+	if err := relayer.cdc.UnmarshalBinaryBare(result.Response.Value, &acknowledgement); err != nil {
+		return transfer.Acknowledgement{}, false, err
+	}
+	return acknowledgement, true, nil
+}
+func (relayer *Relayer) hasAppHash(chain Chain, counterparty Chain, height int64) (bool, error) {
+	latestHeight, err := chain.LatestHeight()
+	if err != nil {
+		return false, err
+	}
+
+	path := fmt.Sprintf("/store/%s/key", zone.StoreKey)
+	result, err := chain.Client.ABCIQueryWithOptions(path, zone.GetAppHashKey(counterparty.ChainID, height), rpcClient.ABCIQueryOptions{Height: latestHeight})
+	if err != nil {
+		return false, err
+	}
+	if !result.Response.IsOK() {
+		return false, fmt.Errorf("app hash query on %s failed: %s", chain.ChainID, result.Response.Log)
+	}
+	return len(result.Response.Value) > 0, nil
+}
+func (relayer *Relayer) withHeaderUpdate(chain Chain, counterparty Chain, height int64, msgs []sdkTypes.Msg) ([]sdkTypes.Msg, error) {
+	found, err := relayer.hasAppHash(chain, counterparty, height)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return msgs, nil
+	}
+
+	signedHeader, validators, err := counterparty.SignedHeader(height)
+	if err != nil {
+		return nil, err
+	}
+	return append([]sdkTypes.Msg{zone.NewMsgUpdateZoneHeader(chain.address, counterparty.ChainID, signedHeader, validators)}, msgs...), nil
+}
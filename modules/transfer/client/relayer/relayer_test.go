@@ -0,0 +1,437 @@
+package relayer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptoKeys "github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/libs/log"
+	rpcClient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tendermintTypes "github.com/tendermint/tendermint/types"
+	"github.com/tendermint/tendermint/version"
+	dbm "github.com/tendermint/tm-db"
+
+	hubApplication "github.com/commitHub/commitBlockchain/applications/hub"
+	zoneApplication "github.com/commitHub/commitBlockchain/applications/zone"
+	hubAsset "github.com/commitHub/commitBlockchain/modules/hub/asset"
+	hubFiat "github.com/commitHub/commitBlockchain/modules/hub/fiat"
+	"github.com/commitHub/commitBlockchain/modules/hub/zone"
+	"github.com/commitHub/commitBlockchain/modules/transfer"
+	"github.com/commitHub/commitBlockchain/modules/zone/access"
+	zoneAsset "github.com/commitHub/commitBlockchain/modules/zone/asset"
+	zoneFiat "github.com/commitHub/commitBlockchain/modules/zone/fiat"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+const (
+	testHubChainID  = "test-hub"
+	testZoneChainID = "test-zone"
+	testPassphrase  = "12345678"
+	testGas         = 2000000
+)
+
+// testNode runs an application in process and implements the parts of the
+// rpc client used by the relayer. Every broadcast transaction is committed in
+// its own block, signed by a single mock validator.
+type testNode struct {
+	rpcClient.Client
+
+	t             *testing.T
+	chainID       string
+	application   abciTypes.Application
+	privValidator tendermintTypes.PrivValidator
+	validatorSet  *tendermintTypes.ValidatorSet
+	genesisTime   time.Time
+	height        int64
+	appHash       []byte
+	lastBlockID   tendermintTypes.BlockID
+	commits       map[int64]*ctypes.ResultCommit
+}
+
+func newTestNode(t *testing.T, cdc *codec.Codec, chainID string, application abciTypes.Application, genesisState map[string]json.RawMessage) *testNode {
+	appStateBytes, err := codec.MarshalJSONIndent(cdc, genesisState)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	privValidator := tendermintTypes.NewMockPV()
+	node := &testNode{
+		t:             t,
+		chainID:       chainID,
+		application:   application,
+		privValidator: privValidator,
+		validatorSet:  tendermintTypes.NewValidatorSet([]*tendermintTypes.Validator{tendermintTypes.NewValidator(privValidator.GetPubKey(), 10)}),
+		genesisTime:   time.Now().UTC(),
+		commits:       make(map[int64]*ctypes.ResultCommit),
+	}
+	application.InitChain(abciTypes.RequestInitChain{Time: node.genesisTime, ChainId: chainID, AppStateBytes: appStateBytes})
+	return node
+}
+func (node *testNode) commitBlock(txs ...tendermintTypes.Tx) []abciTypes.ResponseDeliverTx {
+	node.height++
+	header := tendermintTypes.Header{
+		Version:            version.Consensus{Block: version.BlockProtocol},
+		ChainID:            node.chainID,
+		Height:             node.height,
+		Time:               node.genesisTime.Add(time.Duration(node.height) * time.Second),
+		LastBlockID:        node.lastBlockID,
+		ValidatorsHash:     node.validatorSet.Hash(),
+		NextValidatorsHash: node.validatorSet.Hash(),
+		AppHash:            node.appHash,
+		ProposerAddress:    node.validatorSet.Validators[0].Address,
+	}
+
+	node.application.BeginBlock(abciTypes.RequestBeginBlock{
+		Hash:   header.Hash(),
+		Header: abciTypes.Header{ChainID: header.ChainID, Height: header.Height, Time: header.Time, AppHash: header.AppHash, ProposerAddress: header.ProposerAddress},
+	})
+	var responses []abciTypes.ResponseDeliverTx
+	for _, tx := range txs {
+		responses = append(responses, node.application.DeliverTx(abciTypes.RequestDeliverTx{Tx: tx}))
+	}
+	node.application.EndBlock(abciTypes.RequestEndBlock{Height: node.height})
+	node.appHash = node.application.Commit().Data
+
+	blockID := tendermintTypes.BlockID{Hash: header.Hash()}
+	voteSet := tendermintTypes.NewVoteSet(node.chainID, node.height, 0, tendermintTypes.PrecommitType, node.validatorSet)
+	commit, err := tendermintTypes.MakeCommit(blockID, node.height, 0, voteSet, []tendermintTypes.PrivValidator{node.privValidator})
+	if err != nil {
+		node.t.Fatal(err)
+	}
+	node.commits[node.height] = ctypes.NewResultCommit(&header, commit, true)
+	node.lastBlockID = blockID
+	return responses
+}
+func (node *testNode) Status() (*ctypes.ResultStatus, error) {
+	return &ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{LatestBlockHeight: node.height}}, nil
+}
+func (node *testNode) Commit(height *int64) (*ctypes.ResultCommit, error) {
+	commit, found := node.commits[*height]
+	if !found {
+		return nil, fmt.Errorf("no commit at height %d", *height)
+	}
+	return commit, nil
+}
+func (node *testNode) Validators(height *int64) (*ctypes.ResultValidators, error) {
+	return &ctypes.ResultValidators{BlockHeight: *height, Validators: node.validatorSet.Validators}, nil
+}
+func (node *testNode) ABCIQueryWithOptions(path string, data cmn.HexBytes, opts rpcClient.ABCIQueryOptions) (*ctypes.ResultABCIQuery, error) {
+	response := node.application.Query(abciTypes.RequestQuery{Path: path, Data: data, Height: opts.Height, Prove: opts.Prove})
+	return &ctypes.ResultABCIQuery{Response: response}, nil
+}
+func (node *testNode) BroadcastTxCommit(tx tendermintTypes.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
+	checkTx := node.application.CheckTx(abciTypes.RequestCheckTx{Tx: tx})
+	if !checkTx.IsOK() {
+		return &ctypes.ResultBroadcastTxCommit{CheckTx: checkTx}, nil
+	}
+	deliverTx := node.commitBlock(tx)[0]
+	return &ctypes.ResultBroadcastTxCommit{CheckTx: checkTx, DeliverTx: deliverTx, Hash: tx.Hash(), Height: node.height}, nil
+}
+
+type testEnvironment struct {
+	hubCdc  *codec.Codec
+	zoneCdc *codec.Codec
+	keybase cryptoKeys.Keybase
+	hub     *testNode
+	zone    *testNode
+}
+
+func newTestEnvironment(t *testing.T) *testEnvironment {
+	environment := &testEnvironment{
+		hubCdc:  hubApplication.MakeCodec(),
+		zoneCdc: zoneApplication.MakeCodec(),
+		keybase: cryptoKeys.NewInMemory(),
+	}
+	for _, name := range []string{"relayer", "hubUser", "bank", "zoneUser"} {
+		if _, _, err := environment.keybase.CreateMnemonic(name, cryptoKeys.English, testPassphrase, cryptoKeys.Secp256k1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	relayerAddress := environment.address(t, "relayer")
+
+	hubGenesisState := hubApplication.NewDefaultGenesisState()
+	hubGenesisState[genaccounts.ModuleName] = environment.hubCdc.MustMarshalJSON(environment.genesisAccounts(t, "relayer", "hubUser"))
+	hubGenesisState[zone.ModuleName] = environment.hubCdc.MustMarshalJSON(zone.NewGenesisState(zone.NewParams([]sdkTypes.AccAddress{relayerAddress}), nil))
+	hubGenesisState[hubAsset.ModuleName] = environment.hubCdc.MustMarshalJSON(hubAsset.NewGenesisState(hubAsset.NewParams([]sdkTypes.AccAddress{environment.address(t, "hubUser")}), 0, nil))
+	hubGenesisState[hubFiat.ModuleName] = environment.hubCdc.MustMarshalJSON(hubFiat.NewGenesisState(hubFiat.NewParams([]sdkTypes.AccAddress{environment.address(t, "hubUser")}), 0, nil, nil))
+	hubApp := hubApplication.NewCommitHubApplication(log.NewNopLogger(), dbm.NewMemDB(), nil, true, 0, baseapp.SetPruning(store.PruneNothing))
+	environment.hub = newTestNode(t, environment.hubCdc, testHubChainID, hubApp, hubGenesisState)
+
+	bank := access.NewMember(environment.address(t, "bank"), "bank")
+	bank.AddRole(access.RoleZoneAdmin)
+	bank.AddRole(access.RoleBank)
+	zoneUser := access.NewMember(environment.address(t, "zoneUser"), "trader")
+	zoneUser.AddRole(access.RoleTrader)
+	operator := access.NewMember(relayerAddress, "")
+	operator.AddRole(access.RoleZoneAdmin)
+	zoneGenesisState := zoneApplication.NewDefaultGenesisState()
+	zoneGenesisState[genaccounts.ModuleName] = environment.zoneCdc.MustMarshalJSON(environment.genesisAccounts(t, "relayer", "bank", "zoneUser"))
+	zoneGenesisState[zone.ModuleName] = environment.zoneCdc.MustMarshalJSON(zone.NewGenesisState(zone.NewParams([]sdkTypes.AccAddress{relayerAddress}), nil))
+	zoneGenesisState[access.ModuleName] = environment.zoneCdc.MustMarshalJSON(access.NewGenesisState([]access.Member{bank, zoneUser, operator}))
+	zoneApp := zoneApplication.NewCommitHubApplicaiton(log.NewNopLogger(), dbm.NewMemDB(), nil, true, 0, baseapp.SetPruning(store.PruneNothing))
+	environment.zone = newTestNode(t, environment.zoneCdc, testZoneChainID, zoneApp, zoneGenesisState)
+
+	environment.commitBlocks(2)
+	return environment
+}
+func (environment *testEnvironment) address(t *testing.T, name string) sdkTypes.AccAddress {
+	info, err := environment.keybase.Get(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return info.GetAddress()
+}
+func (environment *testEnvironment) genesisAccounts(t *testing.T, names ...string) genaccounts.GenesisState {
+	var genesisAccounts genaccounts.GenesisState
+	for _, name := range names {
+		coins := sdkTypes.NewCoins(sdkTypes.NewInt64Coin(sdkTypes.DefaultBondDenom, 1000000))
+		genesisAccounts = append(genesisAccounts, genaccounts.NewGenesisAccountRaw(environment.address(t, name), coins, sdkTypes.NewCoins(), 0, 0, "", ""))
+	}
+	return genesisAccounts
+}
+func (environment *testEnvironment) chain(t *testing.T, cdc *codec.Codec, node *testNode, name string) Chain {
+	chain, err := NewChain(cdc, node.chainID, node, environment.keybase, name, testPassphrase, testGas, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return chain
+}
+func (environment *testEnvironment) commitBlocks(count int) {
+	for i := 0; i < count; i++ {
+		environment.hub.commitBlock()
+		environment.zone.commitBlock()
+	}
+}
+
+func broadcast(t *testing.T, chain Chain, msgs ...sdkTypes.Msg) {
+	if err := chain.Broadcast(msgs); err != nil {
+		t.Fatal(err)
+	}
+}
+func query(t *testing.T, chain Chain, path string, params interface{}, result interface{}) {
+	res, err := chain.Query(path, params, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := chain.cdc.UnmarshalJSON(res, result); err != nil {
+		t.Fatal(err)
+	}
+}
+func queryPackets(t *testing.T, chain Chain, destinationChainID string) []transfer.Packet {
+	var packets []transfer.Packet
+	query(t, chain, fmt.Sprintf("custom/%s/%s", transfer.QuerierRoute, transfer.QueryPackets), transfer.NewQueryPacketsParams(destinationChainID), &packets)
+	return packets
+}
+func queryAcknowledgement(t *testing.T, chain Chain, sourceChainID string, sequence uint64) (transfer.Acknowledgement, bool) {
+	latestHeight, err := chain.LatestHeight()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := fmt.Sprintf("/store/%s/key", transfer.StoreKey)
+	result, err := chain.Client.ABCIQueryWithOptions(path, transfer.GetAcknowledgementKey(sourceChainID, sequence), rpcClient.ABCIQueryOptions{Height: latestHeight})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Response.Value) == 0 {
+		return transfer.Acknowledgement{}, false
+	}
+
+	var acknowledgement transfer.Acknowledgement
+	chain.cdc.MustUnmarshalBinaryBare(result.Response.Value, &acknowledgement)
+	return acknowledgement, true
+}
+
+func TestRelayerRestartBetweenBroadcastAndCheckpoint(t *testing.T) {
+	environment := newTestEnvironment(t)
+	hubRelayer := environment.chain(t, environment.hubCdc, environment.hub, "relayer")
+	zoneRelayer := environment.chain(t, environment.hubCdc, environment.zone, "relayer")
+	hubUser := environment.chain(t, environment.hubCdc, environment.hub, "hubUser")
+	bank := environment.chain(t, environment.zoneCdc, environment.zone, "bank")
+	zoneUser := environment.chain(t, environment.zoneCdc, environment.zone, "zoneUser")
+
+	if err := RegisterChain(hubRelayer, zoneRelayer); err != nil {
+		t.Fatal(err)
+	}
+	if err := RegisterChain(zoneRelayer, hubRelayer); err != nil {
+		t.Fatal(err)
+	}
+
+	broadcast(t, hubUser, hubAsset.NewMsgIssueAsset(hubUser.address, hubUser.address, "documentHash", "sugar", 10, "ton"))
+	var hubUserAssets []types.AssetPeg
+	query(t, hubUser, fmt.Sprintf("custom/%s/%s", hubAsset.QuerierRoute, hubAsset.QueryOwnerAssets), hubAsset.NewQueryOwnerAssetsParams(hubUser.address), &hubUserAssets)
+	if len(hubUserAssets) != 1 {
+		t.Fatalf("expected one issued asset, got %d", len(hubUserAssets))
+	}
+	pegHash := hubUserAssets[0].GetPegHash()
+	broadcast(t, hubUser, transfer.NewMsgSendPeg(hubUser.address, zoneUser.address, testZoneChainID, transfer.PegTypeAsset, pegHash, 0, 1000))
+
+	broadcast(t, bank, zoneFiat.NewMsgAttestDeposit(bank.address, zoneUser.address, "deposit-1", 100))
+	broadcast(t, zoneUser, transfer.NewMsgSendPeg(zoneUser.address, hubUser.address, testHubChainID, transfer.PegTypeFiat, nil, 40, 1000))
+	environment.commitBlocks(1)
+
+	checkpointFile := filepath.Join(t.TempDir(), "checkpoint.json")
+	blockedFile := filepath.Join(t.TempDir(), "blocked")
+	if err := ioutil.WriteFile(blockedFile, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	crashed, err := NewRelayer(environment.hubCdc, hubRelayer, zoneRelayer, checkpointFile, log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The checkpoint cannot be written under a regular file, so the relayer
+	// stops after broadcasting the receive and before saving, as a crash would.
+	crashed.checkpointFile = filepath.Join(blockedFile, "checkpoint.json")
+	if err := crashed.RelayOnce(); err == nil {
+		t.Fatal("expected the checkpoint save to fail")
+	}
+	if _, found := queryAcknowledgement(t, zoneRelayer, testHubChainID, 1); !found {
+		t.Fatal("asset packet was not delivered before the crash")
+	}
+	if _, err := os.Stat(checkpointFile); !os.IsNotExist(err) {
+		t.Fatal("checkpoint was saved before the crash")
+	}
+
+	restarted, err := NewRelayer(environment.hubCdc, hubRelayer, zoneRelayer, checkpointFile, log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 5; i++ {
+		environment.commitBlocks(1)
+		if err := restarted.RelayOnce(); err != nil {
+			t.Fatalf("relay round %d: %s", i, err.Error())
+		}
+	}
+
+	if packets := queryPackets(t, hubRelayer, testZoneChainID); len(packets) != 0 {
+		t.Fatalf("hub still has %d unsettled packets", len(packets))
+	}
+	if packets := queryPackets(t, zoneRelayer, testHubChainID); len(packets) != 0 {
+		t.Fatalf("zone still has %d unsettled packets", len(packets))
+	}
+	for _, acknowledgement := range []struct {
+		chain         Chain
+		sourceChainID string
+	}{{zoneRelayer, testHubChainID}, {hubRelayer, testZoneChainID}} {
+		ack, found := queryAcknowledgement(t, acknowledgement.chain, acknowledgement.sourceChainID, 1)
+		if !found || !ack.Success {
+			t.Fatalf("packet from %s was not received successfully: %v", acknowledgement.sourceChainID, ack)
+		}
+		if _, found := queryAcknowledgement(t, acknowledgement.chain, acknowledgement.sourceChainID, 2); found {
+			t.Fatalf("unexpected second packet from %s", acknowledgement.sourceChainID)
+		}
+	}
+
+	var zoneUserAssets []types.AssetPeg
+	query(t, zoneUser, fmt.Sprintf("custom/%s/%s", zoneAsset.QuerierRoute, zoneAsset.QueryOwnerAssets), zoneAsset.NewQueryOwnerAssetsParams(zoneUser.address), &zoneUserAssets)
+	if len(zoneUserAssets) != 1 {
+		t.Fatalf("expected the zone user to own one asset, got %d", len(zoneUserAssets))
+	}
+	var hubAssetPeg types.AssetPeg
+	query(t, hubUser, fmt.Sprintf("custom/%s/%s", hubAsset.QuerierRoute, hubAsset.QueryAsset), hubAsset.NewQueryAssetParams(pegHash), &hubAssetPeg)
+	if !hubAssetPeg.GetOwnerAddress().Equals(transfer.GetEscrowAddress(testZoneChainID)) {
+		t.Fatalf("expected the hub asset to be escrowed for %s, owner is %s", testZoneChainID, hubAssetPeg.GetOwnerAddress())
+	}
+
+	var hubUserFiats types.FiatPegWallet
+	query(t, hubUser, fmt.Sprintf("custom/%s/%s", hubFiat.QuerierRoute, hubFiat.QueryOwnerFiats), hubFiat.NewQueryOwnerFiatsParams(hubUser.address), &hubUserFiats)
+	if amount := hubUserFiats.AmountOf(hubUser.address); amount != 40 {
+		t.Fatalf("expected the hub user to hold 40 fiat, got %d", amount)
+	}
+	var zoneUserFiats types.FiatPegWallet
+	query(t, zoneUser, fmt.Sprintf("custom/%s/%s", zoneFiat.QuerierRoute, zoneFiat.QueryOwnerFiats), zoneFiat.NewQueryOwnerFiatsParams(zoneUser.address), &zoneUserFiats)
+	if amount := zoneUserFiats.AmountOf(zoneUser.address); amount != 60 {
+		t.Fatalf("expected the zone user to hold 60 fiat, got %d", amount)
+	}
+}
+
+func TestHubFiatRoundTrip(t *testing.T) {
+	environment := newTestEnvironment(t)
+	hubRelayer := environment.chain(t, environment.hubCdc, environment.hub, "relayer")
+	zoneRelayer := environment.chain(t, environment.hubCdc, environment.zone, "relayer")
+	hubUser := environment.chain(t, environment.hubCdc, environment.hub, "hubUser")
+	zoneUser := environment.chain(t, environment.zoneCdc, environment.zone, "zoneUser")
+
+	if err := RegisterChain(hubRelayer, zoneRelayer); err != nil {
+		t.Fatal(err)
+	}
+	if err := RegisterChain(zoneRelayer, hubRelayer); err != nil {
+		t.Fatal(err)
+	}
+	relayer, err := NewRelayer(environment.hubCdc, hubRelayer, zoneRelayer, filepath.Join(t.TempDir(), "checkpoint.json"), log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	relay := func() {
+		for i := 0; i < 5; i++ {
+			environment.commitBlocks(1)
+			if err := relayer.RelayOnce(); err != nil {
+				t.Fatalf("relay round %d: %s", i, err.Error())
+			}
+		}
+	}
+	hubFiatAmount := func(address sdkTypes.AccAddress) int64 {
+		var fiatPegWallet types.FiatPegWallet
+		query(t, hubUser, fmt.Sprintf("custom/%s/%s", hubFiat.QuerierRoute, hubFiat.QueryOwnerFiats), hubFiat.NewQueryOwnerFiatsParams(address), &fiatPegWallet)
+		return fiatPegWallet.AmountOf(address)
+	}
+	zoneFiatAmount := func(address sdkTypes.AccAddress) int64 {
+		var fiatPegWallet types.FiatPegWallet
+		query(t, zoneUser, fmt.Sprintf("custom/%s/%s", zoneFiat.QuerierRoute, zoneFiat.QueryOwnerFiats), zoneFiat.NewQueryOwnerFiatsParams(address), &fiatPegWallet)
+		return fiatPegWallet.AmountOf(address)
+	}
+
+	broadcast(t, hubUser, hubFiat.NewMsgIssueFiat(hubUser.address, hubUser.address, "deposit-1", 100))
+	broadcast(t, hubUser, transfer.NewMsgSendPeg(hubUser.address, zoneUser.address, testZoneChainID, transfer.PegTypeFiat, nil, 30, 1000))
+	relay()
+
+	if amount := hubFiatAmount(hubUser.address); amount != 70 {
+		t.Fatalf("expected the hub user to hold 70 fiat, got %d", amount)
+	}
+	if amount := hubFiatAmount(transfer.GetEscrowAddress(testZoneChainID)); amount != 30 {
+		t.Fatalf("expected 30 fiat escrowed for %s on the hub, got %d", testZoneChainID, amount)
+	}
+	if amount := zoneFiatAmount(zoneUser.address); amount != 30 {
+		t.Fatalf("expected the zone user to hold 30 fiat, got %d", amount)
+	}
+
+	broadcast(t, zoneUser, transfer.NewMsgSendPeg(zoneUser.address, hubUser.address, testHubChainID, transfer.PegTypeFiat, nil, 30, 1000))
+	relay()
+
+	if amount := zoneFiatAmount(zoneUser.address); amount != 0 {
+		t.Fatalf("expected the zone user to hold no fiat, got %d", amount)
+	}
+	if amount := hubFiatAmount(transfer.GetEscrowAddress(testZoneChainID)); amount != 0 {
+		t.Fatalf("expected the hub escrow for %s to be released, got %d", testZoneChainID, amount)
+	}
+	var hubUserFiats types.FiatPegWallet
+	query(t, hubUser, fmt.Sprintf("custom/%s/%s", hubFiat.QuerierRoute, hubFiat.QueryOwnerFiats), hubFiat.NewQueryOwnerFiatsParams(hubUser.address), &hubUserFiats)
+	if amount := hubUserFiats.AmountOf(hubUser.address); amount != 100 {
+		t.Fatalf("expected the hub user to hold all 100 fiat again, got %d", amount)
+	}
+	for _, fiatPeg := range hubUserFiats {
+		if fiatPeg.TransactionID != "deposit-1" {
+			t.Fatalf("expected the returned fiat to be the issued peg, got a peg for %s", fiatPeg.TransactionID)
+		}
+	}
+	if packets := queryPackets(t, hubRelayer, testZoneChainID); len(packets) != 0 {
+		t.Fatalf("hub still has %d unsettled packets", len(packets))
+	}
+	if packets := queryPackets(t, zoneRelayer, testHubChainID); len(packets) != 0 {
+		t.Fatalf("zone still has %d unsettled packets", len(packets))
+	}
+}
@@ -0,0 +1,18 @@
+package transfer
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgSendPeg{}, "commit/transfer/MsgSendPeg", nil)
+	cdc.RegisterConcrete(MsgReceivePeg{}, "commit/transfer/MsgReceivePeg", nil)
+	cdc.RegisterConcrete(MsgAcknowledgePeg{}, "commit/transfer/MsgAcknowledgePeg", nil)
+	cdc.RegisterConcrete(MsgTimeoutPeg{}, "commit/transfer/MsgTimeoutPeg", nil)
+}
+
+var msgCdc = codec.New()
+
+func init() {
+	RegisterCodec(msgCdc)
+}
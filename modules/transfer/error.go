@@ -0,0 +1,53 @@
+package transfer
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdkTypes.CodespaceType = "transfer"
+
+	CodeInvalidPacket         sdkTypes.CodeType = 101
+	CodeUnknownPegType        sdkTypes.CodeType = 102
+	CodePacketNotFound        sdkTypes.CodeType = 103
+	CodePacketAlreadyReceived sdkTypes.CodeType = 104
+	CodePacketTimedOut        sdkTypes.CodeType = 105
+	CodePacketNotTimedOut     sdkTypes.CodeType = 106
+	CodeUnknownHeader         sdkTypes.CodeType = 107
+	CodeInvalidProof          sdkTypes.CodeType = 108
+	CodeInvalidTimeout        sdkTypes.CodeType = 109
+	CodeUnknownChain          sdkTypes.CodeType = 110
+)
+
+func ErrInvalidPacket(codespace sdkTypes.CodespaceType, msg string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeInvalidPacket, msg)
+}
+func ErrUnknownPegType(codespace sdkTypes.CodespaceType, pegType PegType) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeUnknownPegType, fmt.Sprintf("no handler registered for peg type %d", pegType))
+}
+func ErrPacketNotFound(codespace sdkTypes.CodespaceType, destinationChainID string, sequence uint64) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodePacketNotFound, fmt.Sprintf("no outbound packet %d to %s", sequence, destinationChainID))
+}
+func ErrPacketAlreadyReceived(codespace sdkTypes.CodespaceType, sourceChainID string, sequence uint64) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodePacketAlreadyReceived, fmt.Sprintf("packet %d from %s has already been received", sequence, sourceChainID))
+}
+func ErrPacketTimedOut(codespace sdkTypes.CodespaceType, timeoutHeight int64) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodePacketTimedOut, fmt.Sprintf("packet timed out at height %d", timeoutHeight))
+}
+func ErrPacketNotTimedOut(codespace sdkTypes.CodespaceType, timeoutHeight int64, proofHeight int64) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodePacketNotTimedOut, fmt.Sprintf("proof height %d is below timeout height %d", proofHeight, timeoutHeight))
+}
+func ErrUnknownHeader(codespace sdkTypes.CodespaceType, chainID string, height int64) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeUnknownHeader, fmt.Sprintf("no verified header of %s at height %d", chainID, height))
+}
+func ErrInvalidProof(codespace sdkTypes.CodespaceType, msg string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeInvalidProof, msg)
+}
+func ErrInvalidTimeout(codespace sdkTypes.CodespaceType, destinationChainID string, timeoutHeight int64, latestHeight int64) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeInvalidTimeout, fmt.Sprintf("timeout height %d is not above the latest verified height %d of %s", timeoutHeight, latestHeight, destinationChainID))
+}
+func ErrUnknownChain(codespace sdkTypes.CodespaceType, chainID string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeUnknownChain, fmt.Sprintf("chain %s is not registered", chainID))
+}
@@ -0,0 +1,11 @@
+package transfer
+
+var (
+	AttributeKeySequence           = "sequence"
+	AttributeKeySourceChainID      = "sourceChainID"
+	AttributeKeyDestinationChainID = "destinationChainID"
+	AttributeKeySender             = "sender"
+	AttributeKeyReceiver           = "receiver"
+	AttributeKeyPegType            = "pegType"
+	AttributeKeySuccess            = "success"
+)
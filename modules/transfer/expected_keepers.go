@@ -0,0 +1,17 @@
+package transfer
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+type ClientKeeper interface {
+	GetLatestHeight(ctx sdkTypes.Context, chainID string) (int64, bool)
+	GetAppHash(ctx sdkTypes.Context, chainID string, height int64) ([]byte, bool)
+}
+
+type PegHandler interface {
+	OnSendPacket(ctx sdkTypes.Context, packet Packet) (Packet, sdkTypes.Error)
+	OnReceivePacket(ctx sdkTypes.Context, packet Packet) sdkTypes.Error
+	OnAcknowledgePacket(ctx sdkTypes.Context, packet Packet, success bool) sdkTypes.Error
+	OnTimeoutPacket(ctx sdkTypes.Context, packet Packet) sdkTypes.Error
+}
@@ -0,0 +1,130 @@
+package transfer
+
+import (
+	"fmt"
+	"strings"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+type GenesisSequence struct {
+	DestinationChainID string `json:"destinationChainID"`
+	NextSequence       uint64 `json:"nextSequence"`
+}
+
+func NewGenesisSequence(destinationChainID string, nextSequence uint64) GenesisSequence {
+	return GenesisSequence{
+		DestinationChainID: destinationChainID,
+		NextSequence:       nextSequence,
+	}
+}
+
+type GenesisAcknowledgement struct {
+	SourceChainID   string          `json:"sourceChainID"`
+	Sequence        uint64          `json:"sequence"`
+	Acknowledgement Acknowledgement `json:"acknowledgement"`
+}
+
+func NewGenesisAcknowledgement(sourceChainID string, sequence uint64, acknowledgement Acknowledgement) GenesisAcknowledgement {
+	return GenesisAcknowledgement{
+		SourceChainID:   sourceChainID,
+		Sequence:        sequence,
+		Acknowledgement: acknowledgement,
+	}
+}
+
+type GenesisState struct {
+	Sequences        []GenesisSequence        `json:"sequences"`
+	Packets          []Packet                 `json:"packets"`
+	Acknowledgements []GenesisAcknowledgement `json:"acknowledgements"`
+}
+
+func NewGenesisState(sequences []GenesisSequence, packets []Packet, acknowledgements []GenesisAcknowledgement) GenesisState {
+	return GenesisState{
+		Sequences:        sequences,
+		Packets:          packets,
+		Acknowledgements: acknowledgements,
+	}
+}
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState([]GenesisSequence{}, []Packet{}, []GenesisAcknowledgement{})
+}
+func InitGenesis(ctx sdkTypes.Context, keeper Keeper, genesisState GenesisState) {
+	for _, sequence := range genesisState.Sequences {
+		keeper.setNextSequence(ctx, sequence.DestinationChainID, sequence.NextSequence)
+	}
+	for _, packet := range genesisState.Packets {
+		keeper.setPacket(ctx, packet)
+	}
+	for _, acknowledgement := range genesisState.Acknowledgements {
+		keeper.setAcknowledgement(ctx, acknowledgement.SourceChainID, acknowledgement.Sequence, acknowledgement.Acknowledgement)
+	}
+}
+func ExportGenesis(ctx sdkTypes.Context, keeper Keeper) GenesisState {
+	var sequences []GenesisSequence
+	keeper.IterateSequences(ctx, func(destinationChainID string, nextSequence uint64) (stop bool) {
+		sequences = append(sequences, NewGenesisSequence(destinationChainID, nextSequence))
+		return false
+	})
+
+	var packets []Packet
+	keeper.IterateAllPackets(ctx, func(packet Packet) (stop bool) {
+		packets = append(packets, packet)
+		return false
+	})
+
+	var acknowledgements []GenesisAcknowledgement
+	keeper.IterateAcknowledgements(ctx, func(sourceChainID string, sequence uint64, acknowledgement Acknowledgement) (stop bool) {
+		acknowledgements = append(acknowledgements, NewGenesisAcknowledgement(sourceChainID, sequence, acknowledgement))
+		return false
+	})
+	return NewGenesisState(sequences, packets, acknowledgements)
+}
+func ValidateGenesis(genesisState GenesisState) error {
+	nextSequences := make(map[string]uint64)
+	for _, sequence := range genesisState.Sequences {
+		if len(strings.TrimSpace(sequence.DestinationChainID)) == 0 {
+			return fmt.Errorf("sequence has an empty destination chain id")
+		}
+		if _, found := nextSequences[sequence.DestinationChainID]; found {
+			return fmt.Errorf("duplicate sequence for destination chain %s", sequence.DestinationChainID)
+		}
+		if sequence.NextSequence == 0 {
+			return fmt.Errorf("next sequence for destination chain %s must be positive", sequence.DestinationChainID)
+		}
+		nextSequences[sequence.DestinationChainID] = sequence.NextSequence
+	}
+
+	seenPackets := make(map[string]bool)
+	for _, packet := range genesisState.Packets {
+		if err := packet.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid packet %d to %s: %s", packet.Sequence, packet.DestinationChainID, err.Error())
+		}
+		if packet.Sequence == 0 || packet.Sequence >= nextSequences[packet.DestinationChainID] {
+			return fmt.Errorf("packet %d to %s is not below the next sequence for that chain", packet.Sequence, packet.DestinationChainID)
+		}
+
+		key := string(GetPacketKey(packet.DestinationChainID, packet.Sequence))
+		if seenPackets[key] {
+			return fmt.Errorf("duplicate packet %d to %s", packet.Sequence, packet.DestinationChainID)
+		}
+		seenPackets[key] = true
+	}
+
+	seenAcknowledgements := make(map[string]bool)
+	for _, acknowledgement := range genesisState.Acknowledgements {
+		if len(strings.TrimSpace(acknowledgement.SourceChainID)) == 0 {
+			return fmt.Errorf("acknowledgement has an empty source chain id")
+		}
+		if acknowledgement.Sequence == 0 {
+			return fmt.Errorf("acknowledgement of packet from %s has a zero sequence", acknowledgement.SourceChainID)
+		}
+
+		key := string(GetAcknowledgementKey(acknowledgement.SourceChainID, acknowledgement.Sequence))
+		if seenAcknowledgements[key] {
+			return fmt.Errorf("duplicate acknowledgement of packet %d from %s", acknowledgement.Sequence, acknowledgement.SourceChainID)
+		}
+		seenAcknowledgements[key] = true
+	}
+	return nil
+}
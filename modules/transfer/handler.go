@@ -0,0 +1,106 @@
+package transfer
+
+import (
+	"fmt"
+	"strconv"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewHandler(keeper Keeper) sdkTypes.Handler {
+	return func(ctx sdkTypes.Context, msg sdkTypes.Msg) sdkTypes.Result {
+		switch msg := msg.(type) {
+		case MsgSendPeg:
+			return handleMsgSendPeg(ctx, keeper, msg)
+		case MsgReceivePeg:
+			return handleMsgReceivePeg(ctx, keeper, msg)
+		case MsgAcknowledgePeg:
+			return handleMsgAcknowledgePeg(ctx, keeper, msg)
+		case MsgTimeoutPeg:
+			return handleMsgTimeoutPeg(ctx, keeper, msg)
+		default:
+			errMsg := fmt.Sprintf("unrecognized transfer message type: %T", msg)
+			return sdkTypes.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+func handleMsgSendPeg(ctx sdkTypes.Context, keeper Keeper, msg MsgSendPeg) sdkTypes.Result {
+	packet := Packet{
+		DestinationChainID: msg.DestinationChainID,
+		SenderAddress:      msg.SenderAddress,
+		ReceiverAddress:    msg.ReceiverAddress,
+		PegType:            msg.PegType,
+		TimeoutHeight:      msg.TimeoutHeight,
+	}
+	switch msg.PegType {
+	case PegTypeAsset:
+		packet.AssetPeg.PegHash = msg.PegHash
+	case PegTypeFiat:
+		packet.FiatAmount = msg.Amount
+	}
+
+	packet, err := keeper.SendPacket(ctx, packet)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdkTypes.NewEvent(msg.Type(), packetAttributes(packet)...),
+	)
+	return sdkTypes.Result{
+		Data:   keeper.cdc.MustMarshalBinaryBare(packet),
+		Events: ctx.EventManager().Events(),
+	}
+}
+func handleMsgReceivePeg(ctx sdkTypes.Context, keeper Keeper, msg MsgReceivePeg) sdkTypes.Result {
+	acknowledgement, err := keeper.ReceivePacket(ctx, msg.Packet, msg.Proof, msg.ProofHeight)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdkTypes.NewEvent(msg.Type(), packetAttributes(msg.Packet)...).AppendAttributes(
+			sdkTypes.NewAttribute(AttributeKeySuccess, strconv.FormatBool(acknowledgement.Success)),
+		),
+	)
+	return sdkTypes.Result{
+		Log:    acknowledgement.Log,
+		Events: ctx.EventManager().Events(),
+	}
+}
+func handleMsgAcknowledgePeg(ctx sdkTypes.Context, keeper Keeper, msg MsgAcknowledgePeg) sdkTypes.Result {
+	if err := keeper.AcknowledgePacket(ctx, msg.Packet, msg.Acknowledgement, msg.Proof, msg.ProofHeight); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdkTypes.NewEvent(msg.Type(), packetAttributes(msg.Packet)...).AppendAttributes(
+			sdkTypes.NewAttribute(AttributeKeySuccess, strconv.FormatBool(msg.Acknowledgement.Success)),
+		),
+	)
+	return sdkTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+func handleMsgTimeoutPeg(ctx sdkTypes.Context, keeper Keeper, msg MsgTimeoutPeg) sdkTypes.Result {
+	if err := keeper.TimeoutPacket(ctx, msg.Packet, msg.Proof, msg.ProofHeight); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdkTypes.NewEvent(msg.Type(), packetAttributes(msg.Packet)...),
+	)
+	return sdkTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+func packetAttributes(packet Packet) []sdkTypes.Attribute {
+	return []sdkTypes.Attribute{
+		sdkTypes.NewAttribute(AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+		sdkTypes.NewAttribute(AttributeKeySourceChainID, packet.SourceChainID),
+		sdkTypes.NewAttribute(AttributeKeyDestinationChainID, packet.DestinationChainID),
+		sdkTypes.NewAttribute(AttributeKeySender, packet.SenderAddress.String()),
+		sdkTypes.NewAttribute(AttributeKeyReceiver, packet.ReceiverAddress.String()),
+		sdkTypes.NewAttribute(AttributeKeyPegType, packet.PegType.String()),
+	}
+}
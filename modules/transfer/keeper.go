@@ -0,0 +1,344 @@
+package transfer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/merkle"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	StoreKey     = "transfer"
+	QuerierRoute = "transfer"
+)
+
+var (
+	SequenceKeyPrefix        = []byte{0x00}
+	PacketKeyPrefix          = []byte{0x01}
+	AcknowledgementKeyPrefix = []byte{0x02}
+)
+
+func GetSequenceKey(destinationChainID string) []byte {
+	return append(SequenceKeyPrefix, []byte(destinationChainID)...)
+}
+func GetPacketsKey(destinationChainID string) []byte {
+	return append(append(PacketKeyPrefix, []byte(destinationChainID)...), '/')
+}
+func GetPacketKey(destinationChainID string, sequence uint64) []byte {
+	sequenceBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(sequenceBytes, sequence)
+	return append(GetPacketsKey(destinationChainID), sequenceBytes...)
+}
+func GetAcknowledgementKey(sourceChainID string, sequence uint64) []byte {
+	sequenceBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(sequenceBytes, sequence)
+	return append(append(append(AcknowledgementKeyPrefix, []byte(sourceChainID)...), '/'), sequenceBytes...)
+}
+func GetProofKeyPath(key []byte) string {
+	return merkle.KeyPath{}.
+		AppendKey([]byte(StoreKey), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingURL).
+		String()
+}
+func GetEscrowAddress(chainID string) sdkTypes.AccAddress {
+	return sdkTypes.AccAddress(crypto.AddressHash([]byte("transfer/escrow/" + chainID)))
+}
+func GetChainAddress(chainID string) sdkTypes.AccAddress {
+	return sdkTypes.AccAddress(crypto.AddressHash([]byte("transfer/chain/" + chainID)))
+}
+
+type Keeper struct {
+	storeKey     sdkTypes.StoreKey
+	cdc          *codec.Codec
+	clientKeeper ClientKeeper
+	pegHandlers  map[PegType]PegHandler
+	codespace    sdkTypes.CodespaceType
+}
+
+func NewKeeper(cdc *codec.Codec, storeKey sdkTypes.StoreKey, clientKeeper ClientKeeper, codespace sdkTypes.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:     storeKey,
+		cdc:          cdc,
+		clientKeeper: clientKeeper,
+		pegHandlers:  make(map[PegType]PegHandler),
+		codespace:    codespace,
+	}
+}
+func (keeper Keeper) Codespace() sdkTypes.CodespaceType {
+	return keeper.codespace
+}
+func (keeper Keeper) AddPegHandler(pegType PegType, pegHandler PegHandler) Keeper {
+	if _, found := keeper.pegHandlers[pegType]; found {
+		panic(fmt.Sprintf("peg handler for %s has already been registered", pegType))
+	}
+	keeper.pegHandlers[pegType] = pegHandler
+	return keeper
+}
+func (keeper Keeper) getPegHandler(pegType PegType) (PegHandler, sdkTypes.Error) {
+	pegHandler, found := keeper.pegHandlers[pegType]
+	if !found {
+		return nil, ErrUnknownPegType(keeper.codespace, pegType)
+	}
+	return pegHandler, nil
+}
+func (keeper Keeper) GetNextSequence(ctx sdkTypes.Context, destinationChainID string) uint64 {
+	store := ctx.KVStore(keeper.storeKey)
+	sequenceBytes := store.Get(GetSequenceKey(destinationChainID))
+	if sequenceBytes == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(sequenceBytes)
+}
+func (keeper Keeper) setNextSequence(ctx sdkTypes.Context, destinationChainID string, sequence uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	sequenceBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(sequenceBytes, sequence)
+	store.Set(GetSequenceKey(destinationChainID), sequenceBytes)
+}
+func (keeper Keeper) IterateSequences(ctx sdkTypes.Context, handler func(destinationChainID string, sequence uint64) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdkTypes.KVStorePrefixIterator(store, SequenceKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		destinationChainID := string(iterator.Key()[len(SequenceKeyPrefix):])
+		if handler(destinationChainID, binary.BigEndian.Uint64(iterator.Value())) {
+			break
+		}
+	}
+}
+func (keeper Keeper) GetPacket(ctx sdkTypes.Context, destinationChainID string, sequence uint64) (Packet, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	packetBytes := store.Get(GetPacketKey(destinationChainID, sequence))
+	if packetBytes == nil {
+		return Packet{}, false
+	}
+
+	var packet Packet
+	keeper.cdc.MustUnmarshalBinaryBare(packetBytes, &packet)
+	return packet, true
+}
+func (keeper Keeper) setPacket(ctx sdkTypes.Context, packet Packet) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(GetPacketKey(packet.DestinationChainID, packet.Sequence), keeper.cdc.MustMarshalBinaryBare(packet))
+}
+func (keeper Keeper) IteratePackets(ctx sdkTypes.Context, destinationChainID string, handler func(packet Packet) (stop bool)) {
+	keeper.iteratePackets(ctx, GetPacketsKey(destinationChainID), handler)
+}
+func (keeper Keeper) IterateAllPackets(ctx sdkTypes.Context, handler func(packet Packet) (stop bool)) {
+	keeper.iteratePackets(ctx, PacketKeyPrefix, handler)
+}
+func (keeper Keeper) iteratePackets(ctx sdkTypes.Context, prefix []byte, handler func(packet Packet) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdkTypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var packet Packet
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &packet)
+		if handler(packet) {
+			break
+		}
+	}
+}
+func (keeper Keeper) GetAcknowledgement(ctx sdkTypes.Context, sourceChainID string, sequence uint64) (Acknowledgement, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	acknowledgementBytes := store.Get(GetAcknowledgementKey(sourceChainID, sequence))
+	if acknowledgementBytes == nil {
+		return Acknowledgement{}, false
+	}
+
+	var acknowledgement Acknowledgement
+	keeper.cdc.MustUnmarshalBinaryBare(acknowledgementBytes, &acknowledgement)
+	return acknowledgement, true
+}
+func (keeper Keeper) setAcknowledgement(ctx sdkTypes.Context, sourceChainID string, sequence uint64, acknowledgement Acknowledgement) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(GetAcknowledgementKey(sourceChainID, sequence), keeper.cdc.MustMarshalBinaryBare(acknowledgement))
+}
+func (keeper Keeper) IterateAcknowledgements(ctx sdkTypes.Context, handler func(sourceChainID string, sequence uint64, acknowledgement Acknowledgement) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdkTypes.KVStorePrefixIterator(store, AcknowledgementKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		sourceChainID := string(key[len(AcknowledgementKeyPrefix) : len(key)-9])
+		sequence := binary.BigEndian.Uint64(key[len(key)-8:])
+
+		var acknowledgement Acknowledgement
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &acknowledgement)
+		if handler(sourceChainID, sequence, acknowledgement) {
+			break
+		}
+	}
+}
+func (keeper Keeper) verifyCommittedPacket(ctx sdkTypes.Context, packet Packet) sdkTypes.Error {
+	store := ctx.KVStore(keeper.storeKey)
+	if packet.SourceChainID != ctx.ChainID() {
+		return ErrInvalidPacket(keeper.codespace, fmt.Sprintf("packet was sent by %s, not %s", packet.SourceChainID, ctx.ChainID()))
+	}
+
+	commitment := store.Get(GetPacketKey(packet.DestinationChainID, packet.Sequence))
+	if commitment == nil {
+		return ErrPacketNotFound(keeper.codespace, packet.DestinationChainID, packet.Sequence)
+	}
+	if !bytes.Equal(commitment, keeper.cdc.MustMarshalBinaryBare(packet)) {
+		return ErrInvalidPacket(keeper.codespace, "packet does not match the committed packet")
+	}
+	return nil
+}
+func (keeper Keeper) getProof(ctx sdkTypes.Context, chainID string, proofBytes []byte, proofHeight int64) ([]byte, *merkle.Proof, sdkTypes.Error) {
+	appHash, found := keeper.clientKeeper.GetAppHash(ctx, chainID, proofHeight)
+	if !found {
+		return nil, nil, ErrUnknownHeader(keeper.codespace, chainID, proofHeight)
+	}
+
+	var proof merkle.Proof
+	if err := proof.Unmarshal(proofBytes); err != nil {
+		return nil, nil, ErrInvalidProof(keeper.codespace, err.Error())
+	}
+	return appHash, &proof, nil
+}
+func (keeper Keeper) verifyMembership(ctx sdkTypes.Context, chainID string, proofBytes []byte, proofHeight int64, key []byte, value []byte) sdkTypes.Error {
+	appHash, proof, err := keeper.getProof(ctx, chainID, proofBytes, proofHeight)
+	if err != nil {
+		return err
+	}
+
+	if err := rootmulti.DefaultProofRuntime().VerifyValue(proof, appHash, GetProofKeyPath(key), value); err != nil {
+		return ErrInvalidProof(keeper.codespace, err.Error())
+	}
+	return nil
+}
+func (keeper Keeper) verifyAbsence(ctx sdkTypes.Context, chainID string, proofBytes []byte, proofHeight int64, key []byte) sdkTypes.Error {
+	appHash, proof, err := keeper.getProof(ctx, chainID, proofBytes, proofHeight)
+	if err != nil {
+		return err
+	}
+
+	if err := rootmulti.DefaultProofRuntime().VerifyAbsence(proof, appHash, GetProofKeyPath(key)); err != nil {
+		return ErrInvalidProof(keeper.codespace, err.Error())
+	}
+	return nil
+}
+func (keeper Keeper) SendPacket(ctx sdkTypes.Context, packet Packet) (Packet, sdkTypes.Error) {
+	pegHandler, err := keeper.getPegHandler(packet.PegType)
+	if err != nil {
+		return Packet{}, err
+	}
+	if packet.DestinationChainID == ctx.ChainID() {
+		return Packet{}, ErrInvalidPacket(keeper.codespace, "cannot send a packet to the local chain")
+	}
+
+	latestHeight, found := keeper.clientKeeper.GetLatestHeight(ctx, packet.DestinationChainID)
+	if !found {
+		return Packet{}, ErrUnknownChain(keeper.codespace, packet.DestinationChainID)
+	}
+	if packet.TimeoutHeight <= latestHeight {
+		return Packet{}, ErrInvalidTimeout(keeper.codespace, packet.DestinationChainID, packet.TimeoutHeight, latestHeight)
+	}
+
+	packet.Sequence = keeper.GetNextSequence(ctx, packet.DestinationChainID)
+	packet.SourceChainID = ctx.ChainID()
+	if err := packet.ValidateBasic(); err != nil {
+		return Packet{}, err
+	}
+
+	packet, err = pegHandler.OnSendPacket(ctx, packet)
+	if err != nil {
+		return Packet{}, err
+	}
+
+	keeper.setPacket(ctx, packet)
+	keeper.setNextSequence(ctx, packet.DestinationChainID, packet.Sequence+1)
+	return packet, nil
+}
+func (keeper Keeper) ReceivePacket(ctx sdkTypes.Context, packet Packet, proofBytes []byte, proofHeight int64) (Acknowledgement, sdkTypes.Error) {
+	store := ctx.KVStore(keeper.storeKey)
+	if packet.DestinationChainID != ctx.ChainID() {
+		return Acknowledgement{}, ErrInvalidPacket(keeper.codespace, fmt.Sprintf("packet is addressed to %s, not %s", packet.DestinationChainID, ctx.ChainID()))
+	}
+	if _, found := keeper.clientKeeper.GetLatestHeight(ctx, packet.SourceChainID); !found {
+		return Acknowledgement{}, ErrUnknownChain(keeper.codespace, packet.SourceChainID)
+	}
+	if ctx.BlockHeight() >= packet.TimeoutHeight {
+		return Acknowledgement{}, ErrPacketTimedOut(keeper.codespace, packet.TimeoutHeight)
+	}
+	if store.Has(GetAcknowledgementKey(packet.SourceChainID, packet.Sequence)) {
+		return Acknowledgement{}, ErrPacketAlreadyReceived(keeper.codespace, packet.SourceChainID, packet.Sequence)
+	}
+
+	packetKey := GetPacketKey(packet.DestinationChainID, packet.Sequence)
+	if err := keeper.verifyMembership(ctx, packet.SourceChainID, proofBytes, proofHeight, packetKey, keeper.cdc.MustMarshalBinaryBare(packet)); err != nil {
+		return Acknowledgement{}, err
+	}
+
+	acknowledgement := NewAcknowledgement(true, "")
+	pegHandler, err := keeper.getPegHandler(packet.PegType)
+	if err == nil {
+		cacheCtx, write := ctx.CacheContext()
+		if err = pegHandler.OnReceivePacket(cacheCtx, packet); err == nil {
+			write()
+		}
+	}
+	if err != nil {
+		acknowledgement = NewAcknowledgement(false, err.Error())
+	}
+
+	keeper.setAcknowledgement(ctx, packet.SourceChainID, packet.Sequence, acknowledgement)
+	return acknowledgement, nil
+}
+func (keeper Keeper) AcknowledgePacket(ctx sdkTypes.Context, packet Packet, acknowledgement Acknowledgement, proofBytes []byte, proofHeight int64) sdkTypes.Error {
+	if err := keeper.verifyCommittedPacket(ctx, packet); err != nil {
+		return err
+	}
+
+	acknowledgementKey := GetAcknowledgementKey(packet.SourceChainID, packet.Sequence)
+	if err := keeper.verifyMembership(ctx, packet.DestinationChainID, proofBytes, proofHeight, acknowledgementKey, keeper.cdc.MustMarshalBinaryBare(acknowledgement)); err != nil {
+		return err
+	}
+
+	pegHandler, err := keeper.getPegHandler(packet.PegType)
+	if err != nil {
+		return err
+	}
+	if err := pegHandler.OnAcknowledgePacket(ctx, packet, acknowledgement.Success); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(GetPacketKey(packet.DestinationChainID, packet.Sequence))
+	return nil
+}
+func (keeper Keeper) TimeoutPacket(ctx sdkTypes.Context, packet Packet, proofBytes []byte, proofHeight int64) sdkTypes.Error {
+	if err := keeper.verifyCommittedPacket(ctx, packet); err != nil {
+		return err
+	}
+	if proofHeight < packet.TimeoutHeight {
+		return ErrPacketNotTimedOut(keeper.codespace, packet.TimeoutHeight, proofHeight)
+	}
+
+	acknowledgementKey := GetAcknowledgementKey(packet.SourceChainID, packet.Sequence)
+	if err := keeper.verifyAbsence(ctx, packet.DestinationChainID, proofBytes, proofHeight, acknowledgementKey); err != nil {
+		return err
+	}
+
+	pegHandler, err := keeper.getPegHandler(packet.PegType)
+	if err != nil {
+		return err
+	}
+	if err := pegHandler.OnTimeoutPacket(ctx, packet); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(GetPacketKey(packet.DestinationChainID, packet.Sequence))
+	return nil
+}
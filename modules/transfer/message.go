@@ -0,0 +1,184 @@
+package transfer
+
+import (
+	"strings"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+const RouterKey = "transfer"
+
+type MsgSendPeg struct {
+	SenderAddress      sdkTypes.AccAddress `json:"senderAddress"`
+	ReceiverAddress    sdkTypes.AccAddress `json:"receiverAddress"`
+	DestinationChainID string              `json:"destinationChainID"`
+	PegType            PegType             `json:"pegType"`
+	PegHash            types.PegHash       `json:"pegHash"`
+	Amount             int64               `json:"amount"`
+	TimeoutHeight      int64               `json:"timeoutHeight"`
+}
+
+func NewMsgSendPeg(senderAddress sdkTypes.AccAddress, receiverAddress sdkTypes.AccAddress, destinationChainID string, pegType PegType, pegHash types.PegHash, amount int64, timeoutHeight int64) MsgSendPeg {
+	return MsgSendPeg{
+		SenderAddress:      senderAddress,
+		ReceiverAddress:    receiverAddress,
+		DestinationChainID: destinationChainID,
+		PegType:            pegType,
+		PegHash:            pegHash,
+		Amount:             amount,
+		TimeoutHeight:      timeoutHeight,
+	}
+}
+func (msg MsgSendPeg) Route() string { return RouterKey }
+func (msg MsgSendPeg) Type() string  { return "sendPeg" }
+func (msg MsgSendPeg) ValidateBasic() sdkTypes.Error {
+	if msg.SenderAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing sender address")
+	}
+	if msg.ReceiverAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing receiver address")
+	}
+	if len(strings.TrimSpace(msg.DestinationChainID)) == 0 {
+		return ErrInvalidPacket(DefaultCodespace, "destination chain id cannot be empty")
+	}
+	if msg.TimeoutHeight <= 0 {
+		return ErrInvalidPacket(DefaultCodespace, "timeout height must be positive")
+	}
+	switch msg.PegType {
+	case PegTypeAsset:
+		if msg.PegHash.Empty() {
+			return types.ErrInvalidPegHash("peg hash cannot be empty")
+		}
+	case PegTypeFiat:
+		if msg.Amount <= 0 {
+			return ErrInvalidPacket(DefaultCodespace, "fiat amount must be positive")
+		}
+	default:
+		return ErrUnknownPegType(DefaultCodespace, msg.PegType)
+	}
+	return nil
+}
+func (msg MsgSendPeg) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+func (msg MsgSendPeg) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.SenderAddress}
+}
+
+type MsgReceivePeg struct {
+	RelayerAddress sdkTypes.AccAddress `json:"relayerAddress"`
+	Packet         Packet              `json:"packet"`
+	Proof          []byte              `json:"proof"`
+	ProofHeight    int64               `json:"proofHeight"`
+}
+
+var _ sdkTypes.Msg = MsgReceivePeg{}
+
+func NewMsgReceivePeg(relayerAddress sdkTypes.AccAddress, packet Packet, proof []byte, proofHeight int64) MsgReceivePeg {
+	return MsgReceivePeg{
+		RelayerAddress: relayerAddress,
+		Packet:         packet,
+		Proof:          proof,
+		ProofHeight:    proofHeight,
+	}
+}
+func (msg MsgReceivePeg) Route() string { return RouterKey }
+func (msg MsgReceivePeg) Type() string  { return "receivePeg" }
+func (msg MsgReceivePeg) ValidateBasic() sdkTypes.Error {
+	if msg.RelayerAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing relayer address")
+	}
+	if err := validateProof(msg.Proof, msg.ProofHeight); err != nil {
+		return err
+	}
+	return msg.Packet.ValidateBasic()
+}
+func (msg MsgReceivePeg) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+func (msg MsgReceivePeg) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.RelayerAddress}
+}
+
+type MsgAcknowledgePeg struct {
+	RelayerAddress  sdkTypes.AccAddress `json:"relayerAddress"`
+	Packet          Packet              `json:"packet"`
+	Acknowledgement Acknowledgement     `json:"acknowledgement"`
+	Proof           []byte              `json:"proof"`
+	ProofHeight     int64               `json:"proofHeight"`
+}
+
+var _ sdkTypes.Msg = MsgAcknowledgePeg{}
+
+func NewMsgAcknowledgePeg(relayerAddress sdkTypes.AccAddress, packet Packet, acknowledgement Acknowledgement, proof []byte, proofHeight int64) MsgAcknowledgePeg {
+	return MsgAcknowledgePeg{
+		RelayerAddress:  relayerAddress,
+		Packet:          packet,
+		Acknowledgement: acknowledgement,
+		Proof:           proof,
+		ProofHeight:     proofHeight,
+	}
+}
+func (msg MsgAcknowledgePeg) Route() string { return RouterKey }
+func (msg MsgAcknowledgePeg) Type() string  { return "acknowledgePeg" }
+func (msg MsgAcknowledgePeg) ValidateBasic() sdkTypes.Error {
+	if msg.RelayerAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing relayer address")
+	}
+	if err := validateProof(msg.Proof, msg.ProofHeight); err != nil {
+		return err
+	}
+	return msg.Packet.ValidateBasic()
+}
+func (msg MsgAcknowledgePeg) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+func (msg MsgAcknowledgePeg) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.RelayerAddress}
+}
+
+type MsgTimeoutPeg struct {
+	RelayerAddress sdkTypes.AccAddress `json:"relayerAddress"`
+	Packet         Packet              `json:"packet"`
+	Proof          []byte              `json:"proof"`
+	ProofHeight    int64               `json:"proofHeight"`
+}
+
+var _ sdkTypes.Msg = MsgTimeoutPeg{}
+
+func NewMsgTimeoutPeg(relayerAddress sdkTypes.AccAddress, packet Packet, proof []byte, proofHeight int64) MsgTimeoutPeg {
+	return MsgTimeoutPeg{
+		RelayerAddress: relayerAddress,
+		Packet:         packet,
+		Proof:          proof,
+		ProofHeight:    proofHeight,
+	}
+}
+func (msg MsgTimeoutPeg) Route() string { return RouterKey }
+func (msg MsgTimeoutPeg) Type() string  { return "timeoutPeg" }
+func (msg MsgTimeoutPeg) ValidateBasic() sdkTypes.Error {
+	if msg.RelayerAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing relayer address")
+	}
+	if err := validateProof(msg.Proof, msg.ProofHeight); err != nil {
+		return err
+	}
+	return msg.Packet.ValidateBasic()
+}
+func (msg MsgTimeoutPeg) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+func (msg MsgTimeoutPeg) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.RelayerAddress}
+}
+func validateProof(proof []byte, proofHeight int64) sdkTypes.Error {
+	if len(proof) == 0 {
+		return ErrInvalidProof(DefaultCodespace, "proof cannot be empty")
+	}
+	if proofHeight <= 0 {
+		return ErrInvalidProof(DefaultCodespace, "proof height must be positive")
+	}
+	return nil
+}
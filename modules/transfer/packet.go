@@ -0,0 +1,103 @@
+package transfer
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+type PegType byte
+
+const (
+	PegTypeAsset PegType = 0x01
+	PegTypeFiat  PegType = 0x02
+)
+
+func PegTypeFromString(str string) (PegType, error) {
+	switch str {
+	case "asset":
+		return PegTypeAsset, nil
+	case "fiat":
+		return PegTypeFiat, nil
+	default:
+		return PegType(0xff), fmt.Errorf("'%s' is not a valid peg type", str)
+	}
+}
+func (pegType PegType) String() string {
+	switch pegType {
+	case PegTypeAsset:
+		return "asset"
+	case PegTypeFiat:
+		return "fiat"
+	default:
+		return ""
+	}
+}
+
+type Packet struct {
+	Sequence           uint64              `json:"sequence"`
+	SourceChainID      string              `json:"sourceChainID"`
+	DestinationChainID string              `json:"destinationChainID"`
+	SenderAddress      sdkTypes.AccAddress `json:"senderAddress"`
+	ReceiverAddress    sdkTypes.AccAddress `json:"receiverAddress"`
+	PegType            PegType             `json:"pegType"`
+	AssetPeg           types.BaseAssetPeg  `json:"assetPeg"`
+	OriginChainID      string              `json:"originChainID"`
+	FiatAmount         int64               `json:"fiatAmount"`
+	TimeoutHeight      int64               `json:"timeoutHeight"`
+}
+
+func (packet Packet) ValidateBasic() sdkTypes.Error {
+	if len(packet.SourceChainID) == 0 || len(packet.DestinationChainID) == 0 {
+		return ErrInvalidPacket(DefaultCodespace, "source and destination chain ids cannot be empty")
+	}
+	if packet.SenderAddress.Empty() || packet.ReceiverAddress.Empty() {
+		return ErrInvalidPacket(DefaultCodespace, "sender and receiver addresses cannot be empty")
+	}
+	if packet.TimeoutHeight <= 0 {
+		return ErrInvalidPacket(DefaultCodespace, "timeout height must be positive")
+	}
+	switch packet.PegType {
+	case PegTypeAsset:
+		if packet.AssetPeg.PegHash.Empty() {
+			return types.ErrInvalidPegHash("peg hash cannot be empty")
+		}
+	case PegTypeFiat:
+		if packet.FiatAmount <= 0 {
+			return ErrInvalidPacket(DefaultCodespace, "fiat amount must be positive")
+		}
+	default:
+		return ErrUnknownPegType(DefaultCodespace, packet.PegType)
+	}
+	return nil
+}
+func (packet Packet) String() string {
+	return fmt.Sprintf(`Packet:
+  Sequence:           %d
+  SourceChainID:      %s
+  DestinationChainID: %s
+  SenderAddress:      %s
+  ReceiverAddress:    %s
+  PegType:            %s
+  PegHash:            %s
+  OriginChainID:      %s
+  FiatAmount:         %d
+  TimeoutHeight:      %d`,
+		packet.Sequence, packet.SourceChainID, packet.DestinationChainID, packet.SenderAddress, packet.ReceiverAddress,
+		packet.PegType, packet.AssetPeg.PegHash, packet.OriginChainID, packet.FiatAmount, packet.TimeoutHeight,
+	)
+}
+
+type Acknowledgement struct {
+	Success bool   `json:"success"`
+	Log     string `json:"log"`
+}
+
+func NewAcknowledgement(success bool, log string) Acknowledgement {
+	return Acknowledgement{
+		Success: success,
+		Log:     log,
+	}
+}
@@ -0,0 +1,120 @@
+package transfer
+
+import (
+	"fmt"
+
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	QueryPacket          = "packet"
+	QueryPackets         = "packets"
+	QueryAcknowledgement = "acknowledgement"
+	QuerySequence        = "sequence"
+)
+
+type QueryPacketParams struct {
+	ChainID  string `json:"chainID"`
+	Sequence uint64 `json:"sequence"`
+}
+
+func NewQueryPacketParams(chainID string, sequence uint64) QueryPacketParams {
+	return QueryPacketParams{
+		ChainID:  chainID,
+		Sequence: sequence,
+	}
+}
+
+type QueryPacketsParams struct {
+	DestinationChainID string `json:"destinationChainID"`
+}
+
+func NewQueryPacketsParams(destinationChainID string) QueryPacketsParams {
+	return QueryPacketsParams{
+		DestinationChainID: destinationChainID,
+	}
+}
+
+func NewQuerier(keeper Keeper) sdkTypes.Querier {
+	return func(ctx sdkTypes.Context, path []string, req abciTypes.RequestQuery) ([]byte, sdkTypes.Error) {
+		switch path[0] {
+		case QueryPacket:
+			return queryPacket(ctx, req, keeper)
+		case QueryPackets:
+			return queryPackets(ctx, req, keeper)
+		case QueryAcknowledgement:
+			return queryAcknowledgement(ctx, req, keeper)
+		case QuerySequence:
+			return querySequence(ctx, req, keeper)
+		default:
+			return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("unknown transfer query endpoint: %s", path[0]))
+		}
+	}
+}
+func queryPacket(ctx sdkTypes.Context, req abciTypes.RequestQuery, keeper Keeper) ([]byte, sdkTypes.Error) {
+	var params QueryPacketParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkTypes.ErrUnknownRequest(sdkTypes.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	packet, found := keeper.GetPacket(ctx, params.ChainID, params.Sequence)
+	if !found {
+		return nil, ErrPacketNotFound(keeper.codespace, params.ChainID, params.Sequence)
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, packet)
+	if err != nil {
+		return nil, sdkTypes.ErrInternal(sdkTypes.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+	return res, nil
+}
+func queryPackets(ctx sdkTypes.Context, req abciTypes.RequestQuery, keeper Keeper) ([]byte, sdkTypes.Error) {
+	var params QueryPacketsParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkTypes.ErrUnknownRequest(sdkTypes.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	packets := []Packet{}
+	keeper.IteratePackets(ctx, params.DestinationChainID, func(packet Packet) (stop bool) {
+		packets = append(packets, packet)
+		return false
+	})
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, packets)
+	if err != nil {
+		return nil, sdkTypes.ErrInternal(sdkTypes.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+	return res, nil
+}
+func queryAcknowledgement(ctx sdkTypes.Context, req abciTypes.RequestQuery, keeper Keeper) ([]byte, sdkTypes.Error) {
+	var params QueryPacketParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkTypes.ErrUnknownRequest(sdkTypes.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	acknowledgement, found := keeper.GetAcknowledgement(ctx, params.ChainID, params.Sequence)
+	if !found {
+		return nil, ErrPacketNotFound(keeper.codespace, params.ChainID, params.Sequence)
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, acknowledgement)
+	if err != nil {
+		return nil, sdkTypes.ErrInternal(sdkTypes.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+	return res, nil
+}
+func querySequence(ctx sdkTypes.Context, req abciTypes.RequestQuery, keeper Keeper) ([]byte, sdkTypes.Error) {
+	var params QueryPacketsParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkTypes.ErrUnknownRequest(sdkTypes.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetNextSequence(ctx, params.DestinationChainID))
+	if err != nil {
+		return nil, sdkTypes.ErrInternal(sdkTypes.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+	return res, nil
+}
@@ -0,0 +1,85 @@
+package access
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Permission decides who may send the messages of a route, or of a single
+// message type on that route when Type is set. Messages that implement
+// RoleRestrictedMsg declare their own roles and need no permission.
+type Permission struct {
+	Route         string
+	Type          string
+	Unrestricted  bool
+	RequiredRoles func(msg sdkTypes.Msg) []Role
+}
+
+func NewUnrestrictedPermission(route string, msgType string) Permission {
+	return Permission{
+		Route:        route,
+		Type:         msgType,
+		Unrestricted: true,
+	}
+}
+func NewMemberPermission(route string, msgType string) Permission {
+	return Permission{
+		Route: route,
+		Type:  msgType,
+	}
+}
+func NewRolePermission(route string, msgType string, roles ...Role) Permission {
+	return Permission{
+		Route: route,
+		Type:  msgType,
+		RequiredRoles: func(msg sdkTypes.Msg) []Role {
+			return roles
+		},
+	}
+}
+func (permission Permission) key() string {
+	if permission.Type == "" {
+		return permission.Route
+	}
+	return permission.Route + "/" + permission.Type
+}
+func NewAnteHandler(keeper Keeper, permissions []Permission, anteHandler sdkTypes.AnteHandler) sdkTypes.AnteHandler {
+	permissionMap := make(map[string]Permission, len(permissions))
+	for _, permission := range permissions {
+		permissionMap[permission.key()] = permission
+	}
+
+	return func(ctx sdkTypes.Context, tx sdkTypes.Tx, simulate bool) (sdkTypes.Context, sdkTypes.Result, bool) {
+		for _, msg := range tx.GetMsgs() {
+			if roleRestrictedMsg, ok := msg.(RoleRestrictedMsg); ok {
+				for _, signer := range msg.GetSigners() {
+					if !keeper.HasAnyRole(ctx, signer, roleRestrictedMsg.GetRequiredRoles()) {
+						return ctx, ErrMissingRole(keeper.codespace, signer, msg.Type()).Result(), true
+					}
+				}
+				continue
+			}
+
+			permission, found := permissionMap[msg.Route()+"/"+msg.Type()]
+			if !found {
+				permission, found = permissionMap[msg.Route()]
+			}
+			if !found {
+				return ctx, ErrUnknownRoute(keeper.codespace, msg.Route(), msg.Type()).Result(), true
+			}
+			if permission.Unrestricted {
+				continue
+			}
+
+			for _, signer := range msg.GetSigners() {
+				if permission.RequiredRoles == nil {
+					if !keeper.IsMember(ctx, signer) {
+						return ctx, ErrMemberNotFound(keeper.codespace, signer).Result(), true
+					}
+				} else if !keeper.HasAnyRole(ctx, signer, permission.RequiredRoles(msg)) {
+					return ctx, ErrMissingRole(keeper.codespace, signer, msg.Type()).Result(), true
+				}
+			}
+		}
+		return anteHandler(ctx, tx, simulate)
+	}
+}
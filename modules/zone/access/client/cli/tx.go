@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/commitHub/commitBlockchain/modules/zone/access"
+)
+
+const flagOrganizationID = "organization-id"
+
+func GetTxCommand(cdc *codec.Codec) *cobra.Command {
+	command := &cobra.Command{
+		Use:                        access.RouterKey,
+		Short:                      "Access control transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	command.AddCommand(client.PostCommands(
+		GrantRoleCommand(cdc),
+		RevokeRoleCommand(cdc),
+	)...)
+	return command
+}
+func GrantRoleCommand(cdc *codec.Codec) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "grant-role [grantee-address] [role]",
+		Short: "Grant a role (zoneAdmin, organizationAdmin, issuer, trader, auditor or bank) to an address",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(command *cobra.Command, args []string) error {
+			transactionBuilder := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			granteeAddress, err := sdkTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			role, err := access.RoleFromString(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := access.NewMsgGrantRole(cliContext.GetFromAddress(), granteeAddress, role, viper.GetString(flagOrganizationID))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliContext, transactionBuilder, []sdkTypes.Msg{msg})
+		},
+	}
+	command.Flags().String(flagOrganizationID, "", "organization the grantee is onboarded into")
+	return command
+}
+func RevokeRoleCommand(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke-role [revokee-address] [role]",
+		Short: "Revoke a role from an address",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(command *cobra.Command, args []string) error {
+			transactionBuilder := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			revokeeAddress, err := sdkTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			role, err := access.RoleFromString(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := access.NewMsgRevokeRole(cliContext.GetFromAddress(), revokeeAddress, role)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliContext, transactionBuilder, []sdkTypes.Msg{msg})
+		},
+	}
+}
@@ -1 +1,16 @@
-package asset
+package access
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgGrantRole{}, "commit/access/MsgGrantRole", nil)
+	cdc.RegisterConcrete(MsgRevokeRole{}, "commit/access/MsgRevokeRole", nil)
+}
+
+var msgCdc = codec.New()
+
+func init() {
+	RegisterCodec(msgCdc)
+}
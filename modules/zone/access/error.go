@@ -1 +1,41 @@
-package asset
+package access
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdkTypes.CodespaceType = "access"
+
+	CodeInvalidRole          sdkTypes.CodeType = 101
+	CodeUnauthorizedGranter  sdkTypes.CodeType = 102
+	CodeMissingRole          sdkTypes.CodeType = 103
+	CodeOrganizationMismatch sdkTypes.CodeType = 104
+	CodeMemberNotFound       sdkTypes.CodeType = 105
+	CodeSelfRevocation       sdkTypes.CodeType = 106
+	CodeUnknownRoute         sdkTypes.CodeType = 107
+)
+
+func ErrInvalidRole(codespace sdkTypes.CodespaceType, role Role) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeInvalidRole, fmt.Sprintf("invalid role %d", role))
+}
+func ErrUnauthorizedGranter(codespace sdkTypes.CodespaceType, granterAddress sdkTypes.AccAddress, role Role) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeUnauthorizedGranter, fmt.Sprintf("%s is not allowed to grant or revoke the %s role", granterAddress, role))
+}
+func ErrMissingRole(codespace sdkTypes.CodespaceType, address sdkTypes.AccAddress, msgType string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeMissingRole, fmt.Sprintf("%s does not hold a role required for %s", address, msgType))
+}
+func ErrOrganizationMismatch(codespace sdkTypes.CodespaceType, address sdkTypes.AccAddress, organizationID string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeOrganizationMismatch, fmt.Sprintf("%s is not a member of organization %s", address, organizationID))
+}
+func ErrMemberNotFound(codespace sdkTypes.CodespaceType, address sdkTypes.AccAddress) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeMemberNotFound, fmt.Sprintf("%s is not a member of this zone", address))
+}
+func ErrSelfRevocation(codespace sdkTypes.CodespaceType) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeSelfRevocation, "zone admins cannot revoke their own admin role")
+}
+func ErrUnknownRoute(codespace sdkTypes.CodespaceType, route string, msgType string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeUnknownRoute, fmt.Sprintf("%s messages on route %s declare no roles and have no permission", msgType, route))
+}
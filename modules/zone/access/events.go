@@ -0,0 +1,8 @@
+package access
+
+var (
+	AttributeKeyGranter        = "granter"
+	AttributeKeyGrantee        = "grantee"
+	AttributeKeyRole           = "role"
+	AttributeKeyOrganizationID = "organizationID"
+)
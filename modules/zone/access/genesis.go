@@ -1 +1,59 @@
-package asset
+package access
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+type GenesisState struct {
+	Members []Member `json:"members"`
+}
+
+func NewGenesisState(members []Member) GenesisState {
+	return GenesisState{
+		Members: members,
+	}
+}
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState([]Member{})
+}
+func InitGenesis(ctx sdkTypes.Context, keeper Keeper, genesisState GenesisState) {
+	for _, member := range genesisState.Members {
+		keeper.SetMember(ctx, member)
+	}
+}
+func ExportGenesis(ctx sdkTypes.Context, keeper Keeper) GenesisState {
+	var members []Member
+	keeper.IterateMembers(ctx, func(member Member) (stop bool) {
+		members = append(members, member)
+		return false
+	})
+	return NewGenesisState(members)
+}
+func ValidateGenesis(genesisState GenesisState) error {
+	seenAddresses := make(map[string]bool)
+	zoneAdminFound := false
+	for _, member := range genesisState.Members {
+		if member.Address.Empty() {
+			return fmt.Errorf("access member has an empty address")
+		}
+		if seenAddresses[member.Address.String()] {
+			return fmt.Errorf("duplicate access member %s", member.Address)
+		}
+		seenAddresses[member.Address.String()] = true
+
+		for _, role := range member.Roles {
+			if !ValidRole(role) {
+				return fmt.Errorf("access member %s has an invalid role %d", member.Address, role)
+			}
+		}
+		if member.HasRole(RoleZoneAdmin) {
+			zoneAdminFound = true
+		}
+	}
+	if len(genesisState.Members) > 0 && !zoneAdminFound {
+		return fmt.Errorf("access genesis must include at least one zone admin")
+	}
+	return nil
+}
@@ -1 +1,59 @@
-package asset
+package access
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewHandler(keeper Keeper) sdkTypes.Handler {
+	return func(ctx sdkTypes.Context, msg sdkTypes.Msg) sdkTypes.Result {
+		switch msg := msg.(type) {
+		case MsgGrantRole:
+			return handleMsgGrantRole(ctx, keeper, msg)
+		case MsgRevokeRole:
+			return handleMsgRevokeRole(ctx, keeper, msg)
+		default:
+			errMsg := fmt.Sprintf("unrecognized access message type: %T", msg)
+			return sdkTypes.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+func handleMsgGrantRole(ctx sdkTypes.Context, keeper Keeper, msg MsgGrantRole) sdkTypes.Result {
+	member, err := keeper.GrantRole(ctx, msg.GranterAddress, msg.GranteeAddress, msg.Role, msg.OrganizationID)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			msg.Type(),
+			sdkTypes.NewAttribute(AttributeKeyGranter, msg.GranterAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyGrantee, msg.GranteeAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyRole, msg.Role.String()),
+			sdkTypes.NewAttribute(AttributeKeyOrganizationID, member.OrganizationID),
+		),
+	)
+	return sdkTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+func handleMsgRevokeRole(ctx sdkTypes.Context, keeper Keeper, msg MsgRevokeRole) sdkTypes.Result {
+	member, err := keeper.RevokeRole(ctx, msg.RevokerAddress, msg.RevokeeAddress, msg.Role)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			msg.Type(),
+			sdkTypes.NewAttribute(AttributeKeyGranter, msg.RevokerAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyGrantee, msg.RevokeeAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyRole, msg.Role.String()),
+			sdkTypes.NewAttribute(AttributeKeyOrganizationID, member.OrganizationID),
+		),
+	)
+	return sdkTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
@@ -1 +1,131 @@
-package asset
+package access
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	StoreKey     = "access"
+	QuerierRoute = "access"
+)
+
+var (
+	MemberKeyPrefix = []byte{0x01}
+)
+
+func GetMemberKey(address sdkTypes.AccAddress) []byte {
+	return append(MemberKeyPrefix, address.Bytes()...)
+}
+
+type Keeper struct {
+	storeKey  sdkTypes.StoreKey
+	cdc       *codec.Codec
+	codespace sdkTypes.CodespaceType
+}
+
+func NewKeeper(cdc *codec.Codec, storeKey sdkTypes.StoreKey, codespace sdkTypes.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:  storeKey,
+		cdc:       cdc,
+		codespace: codespace,
+	}
+}
+func (keeper Keeper) Codespace() sdkTypes.CodespaceType {
+	return keeper.codespace
+}
+func (keeper Keeper) GetMember(ctx sdkTypes.Context, address sdkTypes.AccAddress) (Member, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	memberBytes := store.Get(GetMemberKey(address))
+	if memberBytes == nil {
+		return Member{}, false
+	}
+
+	var member Member
+	keeper.cdc.MustUnmarshalBinaryBare(memberBytes, &member)
+	return member, true
+}
+func (keeper Keeper) SetMember(ctx sdkTypes.Context, member Member) {
+	store := ctx.KVStore(keeper.storeKey)
+	if len(member.Roles) == 0 {
+		store.Delete(GetMemberKey(member.Address))
+		return
+	}
+	store.Set(GetMemberKey(member.Address), keeper.cdc.MustMarshalBinaryBare(member))
+}
+func (keeper Keeper) IterateMembers(ctx sdkTypes.Context, handler func(member Member) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdkTypes.KVStorePrefixIterator(store, MemberKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var member Member
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &member)
+		if handler(member) {
+			break
+		}
+	}
+}
+func (keeper Keeper) IsMember(ctx sdkTypes.Context, address sdkTypes.AccAddress) bool {
+	member, found := keeper.GetMember(ctx, address)
+	return found && len(member.Roles) > 0
+}
+func (keeper Keeper) HasRole(ctx sdkTypes.Context, address sdkTypes.AccAddress, role Role) bool {
+	member, found := keeper.GetMember(ctx, address)
+	return found && member.HasRole(role)
+}
+func (keeper Keeper) HasAnyRole(ctx sdkTypes.Context, address sdkTypes.AccAddress, roles []Role) bool {
+	member, found := keeper.GetMember(ctx, address)
+	return found && member.HasAnyRole(roles)
+}
+func (keeper Keeper) authorizeAdministration(ctx sdkTypes.Context, adminAddress sdkTypes.AccAddress, memberAddress sdkTypes.AccAddress, role Role, organizationID string) sdkTypes.Error {
+	admin, found := keeper.GetMember(ctx, adminAddress)
+	if !found {
+		return ErrUnauthorizedGranter(keeper.codespace, adminAddress, role)
+	}
+	if admin.HasRole(RoleZoneAdmin) {
+		return nil
+	}
+	if !admin.HasRole(RoleOrganizationAdmin) || role.IsAdmin() || role == RoleBank {
+		return ErrUnauthorizedGranter(keeper.codespace, adminAddress, role)
+	}
+	if organizationID != admin.OrganizationID {
+		return ErrOrganizationMismatch(keeper.codespace, memberAddress, admin.OrganizationID)
+	}
+	return nil
+}
+func (keeper Keeper) GrantRole(ctx sdkTypes.Context, granterAddress sdkTypes.AccAddress, granteeAddress sdkTypes.AccAddress, role Role, organizationID string) (Member, sdkTypes.Error) {
+	grantee, found := keeper.GetMember(ctx, granteeAddress)
+	if !found {
+		grantee = NewMember(granteeAddress, organizationID)
+	}
+	if organizationID == "" {
+		organizationID = grantee.OrganizationID
+	}
+	if grantee.OrganizationID != organizationID {
+		return Member{}, ErrOrganizationMismatch(keeper.codespace, granteeAddress, organizationID)
+	}
+	if err := keeper.authorizeAdministration(ctx, granterAddress, granteeAddress, role, organizationID); err != nil {
+		return Member{}, err
+	}
+
+	grantee.AddRole(role)
+	keeper.SetMember(ctx, grantee)
+	return grantee, nil
+}
+func (keeper Keeper) RevokeRole(ctx sdkTypes.Context, revokerAddress sdkTypes.AccAddress, revokeeAddress sdkTypes.AccAddress, role Role) (Member, sdkTypes.Error) {
+	revokee, found := keeper.GetMember(ctx, revokeeAddress)
+	if !found {
+		return Member{}, ErrMemberNotFound(keeper.codespace, revokeeAddress)
+	}
+	if role == RoleZoneAdmin && revokerAddress.Equals(revokeeAddress) {
+		return Member{}, ErrSelfRevocation(keeper.codespace)
+	}
+	if err := keeper.authorizeAdministration(ctx, revokerAddress, revokeeAddress, role, revokee.OrganizationID); err != nil {
+		return Member{}, err
+	}
+
+	revokee.RemoveRole(role)
+	keeper.SetMember(ctx, revokee)
+	return revokee, nil
+}
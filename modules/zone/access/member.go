@@ -0,0 +1,63 @@
+package access
+
+import (
+	"fmt"
+	"strings"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+type Member struct {
+	Address        sdkTypes.AccAddress `json:"address"`
+	OrganizationID string              `json:"organizationID"`
+	Roles          []Role              `json:"roles"`
+}
+
+func NewMember(address sdkTypes.AccAddress, organizationID string) Member {
+	return Member{
+		Address:        address,
+		OrganizationID: organizationID,
+	}
+}
+func (member Member) HasRole(role Role) bool {
+	for _, memberRole := range member.Roles {
+		if memberRole == role {
+			return true
+		}
+	}
+	return false
+}
+func (member Member) HasAnyRole(roles []Role) bool {
+	for _, role := range roles {
+		if member.HasRole(role) {
+			return true
+		}
+	}
+	return false
+}
+func (member *Member) AddRole(role Role) {
+	if !member.HasRole(role) {
+		member.Roles = append(member.Roles, role)
+	}
+}
+func (member *Member) RemoveRole(role Role) {
+	var roles []Role
+	for _, memberRole := range member.Roles {
+		if memberRole != role {
+			roles = append(roles, memberRole)
+		}
+	}
+	member.Roles = roles
+}
+func (member Member) String() string {
+	var roles []string
+	for _, role := range member.Roles {
+		roles = append(roles, role.String())
+	}
+	return fmt.Sprintf(`Member:
+  Address:        %s
+  OrganizationID: %s
+  Roles:          %s`,
+		member.Address, member.OrganizationID, strings.Join(roles, ", "),
+	)
+}
@@ -1 +1,92 @@
-package asset
+package access
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+const RouterKey = "access"
+
+type RoleRestrictedMsg interface {
+	sdkTypes.Msg
+	GetRequiredRoles() []Role
+}
+
+type MsgGrantRole struct {
+	GranterAddress sdkTypes.AccAddress `json:"granterAddress"`
+	GranteeAddress sdkTypes.AccAddress `json:"granteeAddress"`
+	Role           Role                `json:"role"`
+	OrganizationID string              `json:"organizationID"`
+}
+
+var _ RoleRestrictedMsg = MsgGrantRole{}
+
+func NewMsgGrantRole(granterAddress sdkTypes.AccAddress, granteeAddress sdkTypes.AccAddress, role Role, organizationID string) MsgGrantRole {
+	return MsgGrantRole{
+		GranterAddress: granterAddress,
+		GranteeAddress: granteeAddress,
+		Role:           role,
+		OrganizationID: organizationID,
+	}
+}
+func (msg MsgGrantRole) Route() string { return RouterKey }
+func (msg MsgGrantRole) Type() string  { return "grantRole" }
+func (msg MsgGrantRole) ValidateBasic() sdkTypes.Error {
+	if msg.GranterAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing granter address")
+	}
+	if msg.GranteeAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing grantee address")
+	}
+	if !ValidRole(msg.Role) {
+		return ErrInvalidRole(DefaultCodespace, msg.Role)
+	}
+	return nil
+}
+func (msg MsgGrantRole) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+func (msg MsgGrantRole) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.GranterAddress}
+}
+func (msg MsgGrantRole) GetRequiredRoles() []Role {
+	return []Role{RoleZoneAdmin, RoleOrganizationAdmin}
+}
+
+type MsgRevokeRole struct {
+	RevokerAddress sdkTypes.AccAddress `json:"revokerAddress"`
+	RevokeeAddress sdkTypes.AccAddress `json:"revokeeAddress"`
+	Role           Role                `json:"role"`
+}
+
+var _ RoleRestrictedMsg = MsgRevokeRole{}
+
+func NewMsgRevokeRole(revokerAddress sdkTypes.AccAddress, revokeeAddress sdkTypes.AccAddress, role Role) MsgRevokeRole {
+	return MsgRevokeRole{
+		RevokerAddress: revokerAddress,
+		RevokeeAddress: revokeeAddress,
+		Role:           role,
+	}
+}
+func (msg MsgRevokeRole) Route() string { return RouterKey }
+func (msg MsgRevokeRole) Type() string  { return "revokeRole" }
+func (msg MsgRevokeRole) ValidateBasic() sdkTypes.Error {
+	if msg.RevokerAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing revoker address")
+	}
+	if msg.RevokeeAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing revokee address")
+	}
+	if !ValidRole(msg.Role) {
+		return ErrInvalidRole(DefaultCodespace, msg.Role)
+	}
+	return nil
+}
+func (msg MsgRevokeRole) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+func (msg MsgRevokeRole) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.RevokerAddress}
+}
+func (msg MsgRevokeRole) GetRequiredRoles() []Role {
+	return []Role{RoleZoneAdmin, RoleOrganizationAdmin}
+}
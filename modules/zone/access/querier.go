@@ -1 +1,68 @@
-package asset
+package access
+
+import (
+	"fmt"
+
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	QueryMember  = "member"
+	QueryMembers = "members"
+)
+
+type QueryMemberParams struct {
+	Address sdkTypes.AccAddress `json:"address"`
+}
+
+func NewQueryMemberParams(address sdkTypes.AccAddress) QueryMemberParams {
+	return QueryMemberParams{
+		Address: address,
+	}
+}
+
+func NewQuerier(keeper Keeper) sdkTypes.Querier {
+	return func(ctx sdkTypes.Context, path []string, req abciTypes.RequestQuery) ([]byte, sdkTypes.Error) {
+		switch path[0] {
+		case QueryMember:
+			return queryMember(ctx, req, keeper)
+		case QueryMembers:
+			return queryMembers(ctx, keeper)
+		default:
+			return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("unknown access query endpoint: %s", path[0]))
+		}
+	}
+}
+func queryMember(ctx sdkTypes.Context, req abciTypes.RequestQuery, keeper Keeper) ([]byte, sdkTypes.Error) {
+	var params QueryMemberParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkTypes.ErrUnknownRequest(sdkTypes.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	member, found := keeper.GetMember(ctx, params.Address)
+	if !found {
+		return nil, ErrMemberNotFound(keeper.codespace, params.Address)
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, member)
+	if err != nil {
+		return nil, sdkTypes.ErrInternal(sdkTypes.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+	return res, nil
+}
+func queryMembers(ctx sdkTypes.Context, keeper Keeper) ([]byte, sdkTypes.Error) {
+	members := []Member{}
+	keeper.IterateMembers(ctx, func(member Member) (stop bool) {
+		members = append(members, member)
+		return false
+	})
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, members)
+	if err != nil {
+		return nil, sdkTypes.ErrInternal(sdkTypes.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+	return res, nil
+}
@@ -0,0 +1,77 @@
+package access
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type Role uint32
+
+const (
+	RoleZoneAdmin         Role = 0x01
+	RoleOrganizationAdmin Role = 0x02
+	RoleIssuer            Role = 0x03
+	RoleTrader            Role = 0x04
+	RoleAuditor           Role = 0x05
+	RoleBank              Role = 0x06
+)
+
+func RoleFromString(str string) (Role, error) {
+	switch strings.ToLower(str) {
+	case "zoneadmin":
+		return RoleZoneAdmin, nil
+	case "organizationadmin":
+		return RoleOrganizationAdmin, nil
+	case "issuer":
+		return RoleIssuer, nil
+	case "trader":
+		return RoleTrader, nil
+	case "auditor":
+		return RoleAuditor, nil
+	case "bank":
+		return RoleBank, nil
+	default:
+		return Role(0xff), fmt.Errorf("'%s' is not a valid role", str)
+	}
+}
+func ValidRole(role Role) bool {
+	return role >= RoleZoneAdmin && role <= RoleBank
+}
+func (role Role) IsAdmin() bool {
+	return role == RoleZoneAdmin || role == RoleOrganizationAdmin
+}
+func (role Role) String() string {
+	switch role {
+	case RoleZoneAdmin:
+		return "ZoneAdmin"
+	case RoleOrganizationAdmin:
+		return "OrganizationAdmin"
+	case RoleIssuer:
+		return "Issuer"
+	case RoleTrader:
+		return "Trader"
+	case RoleAuditor:
+		return "Auditor"
+	case RoleBank:
+		return "Bank"
+	default:
+		return ""
+	}
+}
+func (role Role) MarshalJSON() ([]byte, error) {
+	return json.Marshal(role.String())
+}
+func (role *Role) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+
+	parsedRole, err := RoleFromString(str)
+	if err != nil {
+		return err
+	}
+	*role = parsedRole
+	return nil
+}
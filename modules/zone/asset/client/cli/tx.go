@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/commitHub/commitBlockchain/modules/zone/asset"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+func GetTxCommand(cdc *codec.Codec) *cobra.Command {
+	command := &cobra.Command{
+		Use:                        asset.RouterKey,
+		Short:                      "Zone asset transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	command.AddCommand(client.PostCommands(
+		IssueAssetCommand(cdc),
+		SendAssetCommand(cdc),
+	)...)
+	return command
+}
+func IssueAssetCommand(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "issue [to-address] [document-hash] [asset-type] [asset-quantity] [quantity-unit]",
+		Short: "Issue a zone asset peg to an address",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(command *cobra.Command, args []string) error {
+			transactionBuilder := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			toAddress, err := sdkTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			assetQuantity, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := asset.NewMsgIssueAsset(cliContext.GetFromAddress(), toAddress, args[1], args[2], assetQuantity, args[4])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliContext, transactionBuilder, []sdkTypes.Msg{msg})
+		},
+	}
+}
+func SendAssetCommand(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "send [to-address] [peg-hash]",
+		Short: "Send a zone asset peg to another address",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(command *cobra.Command, args []string) error {
+			transactionBuilder := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliContext := context.NewCLIContext().WithCodec(cdc)
+
+			toAddress, err := sdkTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			pegHash, err := types.GetPegHashHex(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := asset.NewMsgSendAsset(cliContext.GetFromAddress(), toAddress, pegHash)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliContext, transactionBuilder, []sdkTypes.Msg{msg})
+		},
+	}
+}
@@ -1 +1,19 @@
 package asset
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgIssueAsset{}, "commit/zone/asset/MsgIssueAsset", nil)
+	cdc.RegisterConcrete(MsgSendAsset{}, "commit/zone/asset/MsgSendAsset", nil)
+}
+
+var msgCdc = codec.New()
+
+func init() {
+	RegisterCodec(msgCdc)
+	types.RegisterCodec(msgCdc)
+}
@@ -1 +1,39 @@
 package asset
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+const (
+	DefaultCodespace sdkTypes.CodespaceType = "zoneAsset"
+
+	CodeAssetNotFound           sdkTypes.CodeType = 101
+	CodeUnauthorizedOwner       sdkTypes.CodeType = 102
+	CodeAssetLocked             sdkTypes.CodeType = 103
+	CodeInvalidAsset            sdkTypes.CodeType = 104
+	CodePendingTransferNotFound sdkTypes.CodeType = 105
+	CodeInvalidOrigin           sdkTypes.CodeType = 106
+)
+
+func ErrAssetNotFound(codespace sdkTypes.CodespaceType, pegHash types.PegHash) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeAssetNotFound, fmt.Sprintf("asset with peg hash %s not found", pegHash))
+}
+func ErrUnauthorizedOwner(codespace sdkTypes.CodespaceType, address sdkTypes.AccAddress, pegHash types.PegHash) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeUnauthorizedOwner, fmt.Sprintf("%s does not own asset %s", address, pegHash))
+}
+func ErrAssetLocked(codespace sdkTypes.CodespaceType, pegHash types.PegHash) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeAssetLocked, fmt.Sprintf("asset %s is locked", pegHash))
+}
+func ErrInvalidAsset(codespace sdkTypes.CodespaceType, message string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeInvalidAsset, message)
+}
+func ErrPendingTransferNotFound(codespace sdkTypes.CodespaceType, pegHash types.PegHash) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodePendingTransferNotFound, fmt.Sprintf("no pending hub transfer for asset %s", pegHash))
+}
+func ErrInvalidOrigin(codespace sdkTypes.CodespaceType, msg string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeInvalidOrigin, msg)
+}
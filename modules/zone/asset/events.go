@@ -0,0 +1,8 @@
+package asset
+
+var (
+	AttributeKeyPegHash   = "pegHash"
+	AttributeKeySender    = "sender"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyIssuer    = "issuer"
+)
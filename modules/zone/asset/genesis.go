@@ -1 +1,124 @@
 package asset
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+type GenesisAssetPeg struct {
+	AssetPeg      types.AssetPeg      `json:"assetPeg"`
+	IssuerAddress sdkTypes.AccAddress `json:"issuerAddress"`
+	Origin        AssetOrigin         `json:"origin"`
+}
+
+func NewGenesisAssetPeg(assetPeg types.AssetPeg, issuerAddress sdkTypes.AccAddress, assetOrigin AssetOrigin) GenesisAssetPeg {
+	return GenesisAssetPeg{
+		AssetPeg:      assetPeg,
+		IssuerAddress: issuerAddress,
+		Origin:        assetOrigin,
+	}
+}
+
+type GenesisState struct {
+	PegHashCounter   uint64            `json:"pegHashCounter"`
+	AssetPegs        []GenesisAssetPeg `json:"assetPegs"`
+	PendingTransfers []PendingTransfer `json:"pendingTransfers"`
+}
+
+func NewGenesisState(pegHashCounter uint64, assetPegs []GenesisAssetPeg, pendingTransfers []PendingTransfer) GenesisState {
+	return GenesisState{
+		PegHashCounter:   pegHashCounter,
+		AssetPegs:        assetPegs,
+		PendingTransfers: pendingTransfers,
+	}
+}
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(0, []GenesisAssetPeg{}, []PendingTransfer{})
+}
+func InitGenesis(ctx sdkTypes.Context, keeper Keeper, genesisState GenesisState) {
+	for _, genesisAssetPeg := range genesisState.AssetPegs {
+		keeper.SetAssetPeg(ctx, genesisAssetPeg.AssetPeg)
+		keeper.setIssuer(ctx, genesisAssetPeg.AssetPeg.GetPegHash(), genesisAssetPeg.IssuerAddress)
+		if !genesisAssetPeg.Origin.Empty() {
+			keeper.setAssetOrigin(ctx, genesisAssetPeg.AssetPeg.GetPegHash(), genesisAssetPeg.Origin)
+		}
+	}
+	for _, pendingTransfer := range genesisState.PendingTransfers {
+		keeper.SetPendingTransfer(ctx, pendingTransfer)
+	}
+	keeper.setPegHashCounter(ctx, genesisState.PegHashCounter)
+}
+func ExportGenesis(ctx sdkTypes.Context, keeper Keeper) GenesisState {
+	var assetPegs []GenesisAssetPeg
+	keeper.IterateAssetPegs(ctx, func(assetPeg types.AssetPeg) (stop bool) {
+		pegHash := assetPeg.GetPegHash()
+		assetOrigin, _ := keeper.GetAssetOrigin(ctx, pegHash)
+		assetPegs = append(assetPegs, NewGenesisAssetPeg(assetPeg, keeper.GetIssuer(ctx, pegHash), assetOrigin))
+		return false
+	})
+
+	var pendingTransfers []PendingTransfer
+	keeper.IteratePendingTransfers(ctx, func(pendingTransfer PendingTransfer) (stop bool) {
+		pendingTransfers = append(pendingTransfers, pendingTransfer)
+		return false
+	})
+	return NewGenesisState(keeper.getPegHashCounter(ctx), assetPegs, pendingTransfers)
+}
+func ValidateGenesis(genesisState GenesisState) error {
+	lockedPegHashes := make(map[string]bool)
+	seenOrigins := make(map[string]bool)
+	for _, genesisAssetPeg := range genesisState.AssetPegs {
+		if genesisAssetPeg.AssetPeg == nil {
+			return fmt.Errorf("asset genesis contains an empty asset peg")
+		}
+		if err := genesisAssetPeg.AssetPeg.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid asset peg %s: %s", genesisAssetPeg.AssetPeg.GetPegHash(), err.Error())
+		}
+
+		pegHash := genesisAssetPeg.AssetPeg.GetPegHash()
+		if _, found := lockedPegHashes[pegHash.String()]; found {
+			return fmt.Errorf("duplicate asset peg %s", pegHash)
+		}
+		lockedPegHashes[pegHash.String()] = genesisAssetPeg.AssetPeg.GetLocked()
+
+		if genesisAssetPeg.IssuerAddress.Empty() {
+			return fmt.Errorf("asset peg %s has an empty issuer address", pegHash)
+		}
+		if origin := genesisAssetPeg.Origin; !origin.Empty() {
+			if origin.PegHash.Empty() {
+				return fmt.Errorf("asset peg %s has an origin without a peg hash", pegHash)
+			}
+			if seenOrigins[origin.String()] {
+				return fmt.Errorf("duplicate asset origin %s", origin)
+			}
+			seenOrigins[origin.String()] = true
+		}
+		if len(pegHash) == 8 && binary.BigEndian.Uint64(pegHash) > genesisState.PegHashCounter {
+			return fmt.Errorf("asset peg %s is ahead of the peg hash counter %d", pegHash, genesisState.PegHashCounter)
+		}
+	}
+
+	seenPendingTransfers := make(map[string]bool)
+	for _, pendingTransfer := range genesisState.PendingTransfers {
+		pegHash := pendingTransfer.PegHash
+		if locked, found := lockedPegHashes[pegHash.String()]; !found || !locked {
+			return fmt.Errorf("pending transfer of asset peg %s needs a locked asset peg", pegHash)
+		}
+		if seenPendingTransfers[pegHash.String()] {
+			return fmt.Errorf("duplicate pending transfer of asset peg %s", pegHash)
+		}
+		seenPendingTransfers[pegHash.String()] = true
+
+		if pendingTransfer.OwnerAddress.Empty() || pendingTransfer.HubAddress.Empty() {
+			return fmt.Errorf("pending transfer of asset peg %s has an empty owner or hub address", pegHash)
+		}
+		if pendingTransfer.TimeoutHeight <= 0 {
+			return fmt.Errorf("pending transfer of asset peg %s has a non-positive timeout height", pegHash)
+		}
+	}
+	return nil
+}
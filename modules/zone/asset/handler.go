@@ -1 +1,60 @@
 package asset
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+func NewHandler(keeper Keeper) sdkTypes.Handler {
+	return func(ctx sdkTypes.Context, msg sdkTypes.Msg) sdkTypes.Result {
+		switch msg := msg.(type) {
+		case MsgIssueAsset:
+			return handleMsgIssueAsset(ctx, keeper, msg)
+		case MsgSendAsset:
+			return handleMsgSendAsset(ctx, keeper, msg)
+		default:
+			errMsg := fmt.Sprintf("unrecognized zone asset message type: %T", msg)
+			return sdkTypes.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+func handleMsgIssueAsset(ctx sdkTypes.Context, keeper Keeper, msg MsgIssueAsset) sdkTypes.Result {
+	baseAssetPeg := types.NewBaseAssetPeg(nil, msg.DocumentHash, msg.AssetType, msg.AssetQuantity, msg.QuantityUnit, msg.ToAddress)
+	assetPeg, err := keeper.IssueAsset(ctx, msg.IssuerAddress, &baseAssetPeg)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			msg.Type(),
+			sdkTypes.NewAttribute(AttributeKeyIssuer, msg.IssuerAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyRecipient, msg.ToAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyPegHash, assetPeg.GetPegHash().String()),
+		),
+	)
+	return sdkTypes.Result{
+		Data:   assetPeg.GetPegHash(),
+		Events: ctx.EventManager().Events(),
+	}
+}
+func handleMsgSendAsset(ctx sdkTypes.Context, keeper Keeper, msg MsgSendAsset) sdkTypes.Result {
+	if err := keeper.SendAsset(ctx, msg.FromAddress, msg.ToAddress, msg.PegHash); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			msg.Type(),
+			sdkTypes.NewAttribute(AttributeKeySender, msg.FromAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyRecipient, msg.ToAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyPegHash, msg.PegHash.String()),
+		),
+	)
+	return sdkTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
@@ -1 +1,105 @@
 package asset
+
+import (
+	"strings"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/zone/access"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+const RouterKey = "asset"
+
+type MsgIssueAsset struct {
+	IssuerAddress sdkTypes.AccAddress `json:"issuerAddress"`
+	ToAddress     sdkTypes.AccAddress `json:"toAddress"`
+	DocumentHash  string              `json:"documentHash"`
+	AssetType     string              `json:"assetType"`
+	AssetQuantity int64               `json:"assetQuantity"`
+	QuantityUnit  string              `json:"quantityUnit"`
+}
+
+var _ access.RoleRestrictedMsg = MsgIssueAsset{}
+
+func NewMsgIssueAsset(issuerAddress sdkTypes.AccAddress, toAddress sdkTypes.AccAddress, documentHash string, assetType string, assetQuantity int64, quantityUnit string) MsgIssueAsset {
+	return MsgIssueAsset{
+		IssuerAddress: issuerAddress,
+		ToAddress:     toAddress,
+		DocumentHash:  documentHash,
+		AssetType:     assetType,
+		AssetQuantity: assetQuantity,
+		QuantityUnit:  quantityUnit,
+	}
+}
+func (msg MsgIssueAsset) Route() string { return RouterKey }
+func (msg MsgIssueAsset) Type() string  { return "issueAsset" }
+func (msg MsgIssueAsset) ValidateBasic() sdkTypes.Error {
+	if msg.IssuerAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing issuer address")
+	}
+	if msg.ToAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing recipient address")
+	}
+	if len(strings.TrimSpace(msg.DocumentHash)) == 0 {
+		return ErrInvalidAsset(DefaultCodespace, "document hash cannot be empty")
+	}
+	if len(strings.TrimSpace(msg.AssetType)) == 0 {
+		return ErrInvalidAsset(DefaultCodespace, "asset type cannot be empty")
+	}
+	if msg.AssetQuantity <= 0 {
+		return ErrInvalidAsset(DefaultCodespace, "asset quantity must be positive")
+	}
+	if len(strings.TrimSpace(msg.QuantityUnit)) == 0 {
+		return ErrInvalidAsset(DefaultCodespace, "quantity unit cannot be empty")
+	}
+	return nil
+}
+func (msg MsgIssueAsset) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+func (msg MsgIssueAsset) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.IssuerAddress}
+}
+func (msg MsgIssueAsset) GetRequiredRoles() []access.Role {
+	return []access.Role{access.RoleIssuer}
+}
+
+type MsgSendAsset struct {
+	FromAddress sdkTypes.AccAddress `json:"fromAddress"`
+	ToAddress   sdkTypes.AccAddress `json:"toAddress"`
+	PegHash     types.PegHash       `json:"pegHash"`
+}
+
+var _ access.RoleRestrictedMsg = MsgSendAsset{}
+
+func NewMsgSendAsset(fromAddress sdkTypes.AccAddress, toAddress sdkTypes.AccAddress, pegHash types.PegHash) MsgSendAsset {
+	return MsgSendAsset{
+		FromAddress: fromAddress,
+		ToAddress:   toAddress,
+		PegHash:     pegHash,
+	}
+}
+func (msg MsgSendAsset) Route() string { return RouterKey }
+func (msg MsgSendAsset) Type() string  { return "sendAsset" }
+func (msg MsgSendAsset) ValidateBasic() sdkTypes.Error {
+	if msg.FromAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing sender address")
+	}
+	if msg.ToAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing recipient address")
+	}
+	if msg.PegHash.Empty() {
+		return types.ErrInvalidPegHash("peg hash cannot be empty")
+	}
+	return nil
+}
+func (msg MsgSendAsset) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+func (msg MsgSendAsset) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.FromAddress}
+}
+func (msg MsgSendAsset) GetRequiredRoles() []access.Role {
+	return []access.Role{access.RoleIssuer, access.RoleTrader}
+}
@@ -1 +1,100 @@
 package asset
+
+import (
+	"fmt"
+
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+const (
+	QueryAsset            = "asset"
+	QueryOwnerAssets      = "ownerAssets"
+	QueryPendingTransfers = "pendingTransfers"
+)
+
+type QueryAssetParams struct {
+	PegHash types.PegHash `json:"pegHash"`
+}
+
+func NewQueryAssetParams(pegHash types.PegHash) QueryAssetParams {
+	return QueryAssetParams{
+		PegHash: pegHash,
+	}
+}
+
+type QueryOwnerAssetsParams struct {
+	OwnerAddress sdkTypes.AccAddress `json:"ownerAddress"`
+}
+
+func NewQueryOwnerAssetsParams(ownerAddress sdkTypes.AccAddress) QueryOwnerAssetsParams {
+	return QueryOwnerAssetsParams{
+		OwnerAddress: ownerAddress,
+	}
+}
+
+func NewQuerier(keeper Keeper) sdkTypes.Querier {
+	return func(ctx sdkTypes.Context, path []string, req abciTypes.RequestQuery) ([]byte, sdkTypes.Error) {
+		switch path[0] {
+		case QueryAsset:
+			return queryAsset(ctx, req, keeper)
+		case QueryOwnerAssets:
+			return queryOwnerAssets(ctx, req, keeper)
+		case QueryPendingTransfers:
+			return queryPendingTransfers(ctx, keeper)
+		default:
+			return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("unknown zone asset query endpoint: %s", path[0]))
+		}
+	}
+}
+func queryAsset(ctx sdkTypes.Context, req abciTypes.RequestQuery, keeper Keeper) ([]byte, sdkTypes.Error) {
+	var params QueryAssetParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkTypes.ErrUnknownRequest(sdkTypes.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	assetPeg, found := keeper.GetAssetPeg(ctx, params.PegHash)
+	if !found {
+		return nil, ErrAssetNotFound(keeper.codespace, params.PegHash)
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, assetPeg)
+	if err != nil {
+		return nil, sdkTypes.ErrInternal(sdkTypes.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+	return res, nil
+}
+func queryOwnerAssets(ctx sdkTypes.Context, req abciTypes.RequestQuery, keeper Keeper) ([]byte, sdkTypes.Error) {
+	var params QueryOwnerAssetsParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkTypes.ErrUnknownRequest(sdkTypes.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	assetPegs := keeper.GetAssetPegsByOwner(ctx, params.OwnerAddress)
+	if assetPegs == nil {
+		assetPegs = []types.AssetPeg{}
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, assetPegs)
+	if err != nil {
+		return nil, sdkTypes.ErrInternal(sdkTypes.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+	return res, nil
+}
+func queryPendingTransfers(ctx sdkTypes.Context, keeper Keeper) ([]byte, sdkTypes.Error) {
+	pendingTransfers := []PendingTransfer{}
+	keeper.IteratePendingTransfers(ctx, func(pendingTransfer PendingTransfer) (stop bool) {
+		pendingTransfers = append(pendingTransfers, pendingTransfer)
+		return false
+	})
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, pendingTransfers)
+	if err != nil {
+		return nil, sdkTypes.ErrInternal(sdkTypes.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+	return res, nil
+}
@@ -0,0 +1,34 @@
+package asset
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+type PendingTransfer struct {
+	PegHash       types.PegHash       `json:"pegHash"`
+	OwnerAddress  sdkTypes.AccAddress `json:"ownerAddress"`
+	HubAddress    sdkTypes.AccAddress `json:"hubAddress"`
+	TimeoutHeight int64               `json:"timeoutHeight"`
+}
+
+func NewPendingTransfer(pegHash types.PegHash, ownerAddress sdkTypes.AccAddress, hubAddress sdkTypes.AccAddress, timeoutHeight int64) PendingTransfer {
+	return PendingTransfer{
+		PegHash:       pegHash,
+		OwnerAddress:  ownerAddress,
+		HubAddress:    hubAddress,
+		TimeoutHeight: timeoutHeight,
+	}
+}
+func (pendingTransfer PendingTransfer) String() string {
+	return fmt.Sprintf(`PendingTransfer:
+  PegHash:       %s
+  OwnerAddress:  %s
+  HubAddress:    %s
+  TimeoutHeight: %d`,
+		pendingTransfer.PegHash, pendingTransfer.OwnerAddress, pendingTransfer.HubAddress, pendingTransfer.TimeoutHeight,
+	)
+}
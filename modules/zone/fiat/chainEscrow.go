@@ -0,0 +1,33 @@
+package fiat
+
+import (
+	"fmt"
+)
+
+type ChainEscrow struct {
+	ChainID       string `json:"chainID"`
+	PendingAmount int64  `json:"pendingAmount"`
+}
+
+func NewChainEscrow(chainID string, pendingAmount int64) ChainEscrow {
+	return ChainEscrow{
+		ChainID:       chainID,
+		PendingAmount: pendingAmount,
+	}
+}
+func (chainEscrow ChainEscrow) Validate() error {
+	if len(chainEscrow.ChainID) == 0 {
+		return fmt.Errorf("chain escrow chain id cannot be empty")
+	}
+	if chainEscrow.PendingAmount < 0 {
+		return fmt.Errorf("chain escrow pending amount of %s cannot be negative", chainEscrow.ChainID)
+	}
+	return nil
+}
+func (chainEscrow ChainEscrow) String() string {
+	return fmt.Sprintf(`ChainEscrow:
+  ChainID:       %s
+  PendingAmount: %d`,
+		chainEscrow.ChainID, chainEscrow.PendingAmount,
+	)
+}
@@ -1 +1,18 @@
-package asset
+package fiat
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgAttestDeposit{}, "commit/zone/fiat/MsgAttestDeposit", nil)
+	cdc.RegisterConcrete(MsgSendFiat{}, "commit/zone/fiat/MsgSendFiat", nil)
+	cdc.RegisterConcrete(MsgRequestRedemption{}, "commit/zone/fiat/MsgRequestRedemption", nil)
+	cdc.RegisterConcrete(MsgSettleRedemption{}, "commit/zone/fiat/MsgSettleRedemption", nil)
+}
+
+var msgCdc = codec.New()
+
+func init() {
+	RegisterCodec(msgCdc)
+}
@@ -1 +1,37 @@
-package asset
+package fiat
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdkTypes.CodespaceType = "zoneFiat"
+
+	CodeTransactionIDExists  sdkTypes.CodeType = 101
+	CodeInvalidAmount        sdkTypes.CodeType = 102
+	CodeInvalidTransactionID sdkTypes.CodeType = 103
+	CodeRedemptionNotFound   sdkTypes.CodeType = 104
+	CodeRedemptionSettled    sdkTypes.CodeType = 105
+	CodeUnauthorizedBank     sdkTypes.CodeType = 106
+)
+
+func ErrTransactionIDExists(codespace sdkTypes.CodespaceType, transactionID string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeTransactionIDExists, fmt.Sprintf("deposit %s has already been attested", transactionID))
+}
+func ErrInvalidAmount(codespace sdkTypes.CodespaceType, message string) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeInvalidAmount, message)
+}
+func ErrInvalidTransactionID(codespace sdkTypes.CodespaceType) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeInvalidTransactionID, "transaction id cannot be empty")
+}
+func ErrRedemptionNotFound(codespace sdkTypes.CodespaceType, redemptionID uint64) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeRedemptionNotFound, fmt.Sprintf("redemption request %d not found", redemptionID))
+}
+func ErrRedemptionSettled(codespace sdkTypes.CodespaceType, redemptionID uint64) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeRedemptionSettled, fmt.Sprintf("redemption request %d has already been settled", redemptionID))
+}
+func ErrUnauthorizedBank(codespace sdkTypes.CodespaceType, address sdkTypes.AccAddress, redemptionID uint64) sdkTypes.Error {
+	return sdkTypes.NewError(codespace, CodeUnauthorizedBank, fmt.Sprintf("%s is not the bank of redemption request %d", address, redemptionID))
+}
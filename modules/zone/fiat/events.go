@@ -0,0 +1,11 @@
+package fiat
+
+var (
+	AttributeKeyPegHash       = "pegHash"
+	AttributeKeyTransactionID = "transactionID"
+	AttributeKeySender        = "sender"
+	AttributeKeyRecipient     = "recipient"
+	AttributeKeyBank          = "bank"
+	AttributeKeyRedeemer      = "redeemer"
+	AttributeKeyRedemptionID  = "redemptionID"
+)
@@ -1 +1,136 @@
-package asset
+package fiat
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+type GenesisFiatPeg struct {
+	FiatPeg     types.BaseFiatPeg   `json:"fiatPeg"`
+	BankAddress sdkTypes.AccAddress `json:"bankAddress"`
+}
+
+func NewGenesisFiatPeg(fiatPeg types.BaseFiatPeg, bankAddress sdkTypes.AccAddress) GenesisFiatPeg {
+	return GenesisFiatPeg{
+		FiatPeg:     fiatPeg,
+		BankAddress: bankAddress,
+	}
+}
+
+type GenesisState struct {
+	PegHashCounter    uint64           `json:"pegHashCounter"`
+	FiatPegs          []GenesisFiatPeg `json:"fiatPegs"`
+	RedemptionCounter uint64           `json:"redemptionCounter"`
+	Redemptions       []Redemption     `json:"redemptions"`
+	ChainEscrows      []ChainEscrow    `json:"chainEscrows"`
+}
+
+func NewGenesisState(pegHashCounter uint64, fiatPegs []GenesisFiatPeg, redemptionCounter uint64, redemptions []Redemption, chainEscrows []ChainEscrow) GenesisState {
+	return GenesisState{
+		PegHashCounter:    pegHashCounter,
+		FiatPegs:          fiatPegs,
+		RedemptionCounter: redemptionCounter,
+		Redemptions:       redemptions,
+		ChainEscrows:      chainEscrows,
+	}
+}
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(0, []GenesisFiatPeg{}, 0, []Redemption{}, []ChainEscrow{})
+}
+func InitGenesis(ctx sdkTypes.Context, keeper Keeper, genesisState GenesisState) {
+	for _, genesisFiatPeg := range genesisState.FiatPegs {
+		keeper.SetFiatPeg(ctx, genesisFiatPeg.FiatPeg)
+		keeper.setBank(ctx, genesisFiatPeg.FiatPeg, genesisFiatPeg.BankAddress)
+	}
+	for _, redemption := range genesisState.Redemptions {
+		keeper.SetRedemption(ctx, redemption)
+	}
+	for _, chainEscrow := range genesisState.ChainEscrows {
+		keeper.SetChainEscrow(ctx, chainEscrow)
+	}
+	keeper.setCounter(ctx, PegHashCounterKey, genesisState.PegHashCounter)
+	keeper.setCounter(ctx, RedemptionCounterKey, genesisState.RedemptionCounter)
+}
+func ExportGenesis(ctx sdkTypes.Context, keeper Keeper) GenesisState {
+	var fiatPegs []GenesisFiatPeg
+	keeper.IterateFiatPegs(ctx, func(fiatPeg types.BaseFiatPeg) (stop bool) {
+		fiatPegs = append(fiatPegs, NewGenesisFiatPeg(fiatPeg, keeper.GetBank(ctx, fiatPeg.PegHash)))
+		return false
+	})
+
+	var redemptions []Redemption
+	keeper.IterateRedemptions(ctx, func(redemption Redemption) (stop bool) {
+		redemptions = append(redemptions, redemption)
+		return false
+	})
+
+	var chainEscrows []ChainEscrow
+	keeper.IterateChainEscrows(ctx, func(chainEscrow ChainEscrow) (stop bool) {
+		chainEscrows = append(chainEscrows, chainEscrow)
+		return false
+	})
+	return NewGenesisState(keeper.getCounter(ctx, PegHashCounterKey), fiatPegs, keeper.getCounter(ctx, RedemptionCounterKey), redemptions, chainEscrows)
+}
+func ValidateGenesis(genesisState GenesisState) error {
+	seenPegHashes := make(map[string]bool)
+	seenTransactionIDs := make(map[string]bool)
+	for _, genesisFiatPeg := range genesisState.FiatPegs {
+		fiatPeg := genesisFiatPeg.FiatPeg
+		if err := fiatPeg.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid fiat peg %s: %s", fiatPeg.PegHash, err.Error())
+		}
+		if seenPegHashes[fiatPeg.PegHash.String()] {
+			return fmt.Errorf("duplicate fiat peg %s", fiatPeg.PegHash)
+		}
+		seenPegHashes[fiatPeg.PegHash.String()] = true
+
+		if seenTransactionIDs[fiatPeg.TransactionID] {
+			return fmt.Errorf("duplicate fiat transaction id %s", fiatPeg.TransactionID)
+		}
+		seenTransactionIDs[fiatPeg.TransactionID] = true
+
+		if genesisFiatPeg.BankAddress.Empty() {
+			return fmt.Errorf("fiat peg %s has an empty bank address", fiatPeg.PegHash)
+		}
+		if len(fiatPeg.PegHash) == 8 && binary.BigEndian.Uint64(fiatPeg.PegHash) > genesisState.PegHashCounter {
+			return fmt.Errorf("fiat peg %s is ahead of the peg hash counter %d", fiatPeg.PegHash, genesisState.PegHashCounter)
+		}
+	}
+
+	seenRedemptionIDs := make(map[uint64]bool)
+	for _, redemption := range genesisState.Redemptions {
+		if redemption.RedemptionID == 0 || redemption.RedemptionID > genesisState.RedemptionCounter {
+			return fmt.Errorf("redemption %d is outside the redemption counter %d", redemption.RedemptionID, genesisState.RedemptionCounter)
+		}
+		if seenRedemptionIDs[redemption.RedemptionID] {
+			return fmt.Errorf("duplicate redemption %d", redemption.RedemptionID)
+		}
+		seenRedemptionIDs[redemption.RedemptionID] = true
+
+		if redemption.RedeemerAddress.Empty() || redemption.BankAddress.Empty() {
+			return fmt.Errorf("redemption %d has an empty redeemer or bank address", redemption.RedemptionID)
+		}
+		if redemption.Amount <= 0 {
+			return fmt.Errorf("redemption %d has a non-positive amount %d", redemption.RedemptionID, redemption.Amount)
+		}
+		if redemption.Status.String() == "" {
+			return fmt.Errorf("redemption %d has an invalid status %d", redemption.RedemptionID, redemption.Status)
+		}
+	}
+
+	seenChainIDs := make(map[string]bool)
+	for _, chainEscrow := range genesisState.ChainEscrows {
+		if err := chainEscrow.Validate(); err != nil {
+			return err
+		}
+		if seenChainIDs[chainEscrow.ChainID] {
+			return fmt.Errorf("duplicate chain escrow for %s", chainEscrow.ChainID)
+		}
+		seenChainIDs[chainEscrow.ChainID] = true
+	}
+	return nil
+}
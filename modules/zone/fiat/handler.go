@@ -1 +1,101 @@
-package asset
+package fiat
+
+import (
+	"fmt"
+	"strconv"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewHandler(keeper Keeper) sdkTypes.Handler {
+	return func(ctx sdkTypes.Context, msg sdkTypes.Msg) sdkTypes.Result {
+		switch msg := msg.(type) {
+		case MsgAttestDeposit:
+			return handleMsgAttestDeposit(ctx, keeper, msg)
+		case MsgSendFiat:
+			return handleMsgSendFiat(ctx, keeper, msg)
+		case MsgRequestRedemption:
+			return handleMsgRequestRedemption(ctx, keeper, msg)
+		case MsgSettleRedemption:
+			return handleMsgSettleRedemption(ctx, keeper, msg)
+		default:
+			errMsg := fmt.Sprintf("unrecognized zone fiat message type: %T", msg)
+			return sdkTypes.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+func handleMsgAttestDeposit(ctx sdkTypes.Context, keeper Keeper, msg MsgAttestDeposit) sdkTypes.Result {
+	fiatPeg, err := keeper.AttestDeposit(ctx, msg.BankAddress, msg.DepositorAddress, msg.TransactionID, msg.TransactionAmount)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			msg.Type(),
+			sdkTypes.NewAttribute(AttributeKeyBank, msg.BankAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyRecipient, msg.DepositorAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyTransactionID, msg.TransactionID),
+			sdkTypes.NewAttribute(AttributeKeyPegHash, fiatPeg.PegHash.String()),
+		),
+	)
+	return sdkTypes.Result{
+		Data:   fiatPeg.PegHash,
+		Events: ctx.EventManager().Events(),
+	}
+}
+func handleMsgSendFiat(ctx sdkTypes.Context, keeper Keeper, msg MsgSendFiat) sdkTypes.Result {
+	if err := keeper.SendFiat(ctx, msg.FromAddress, msg.ToAddress, msg.Amount); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			msg.Type(),
+			sdkTypes.NewAttribute(AttributeKeySender, msg.FromAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyRecipient, msg.ToAddress.String()),
+		),
+	)
+	return sdkTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+func handleMsgRequestRedemption(ctx sdkTypes.Context, keeper Keeper, msg MsgRequestRedemption) sdkTypes.Result {
+	redemption, err := keeper.RequestRedemption(ctx, msg.RedeemerAddress, msg.BankAddress, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	redemptionID := strconv.FormatUint(redemption.RedemptionID, 10)
+	ctx.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			msg.Type(),
+			sdkTypes.NewAttribute(AttributeKeyRedeemer, msg.RedeemerAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyBank, msg.BankAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyRedemptionID, redemptionID),
+		),
+	)
+	return sdkTypes.Result{
+		Data:   []byte(redemptionID),
+		Events: ctx.EventManager().Events(),
+	}
+}
+func handleMsgSettleRedemption(ctx sdkTypes.Context, keeper Keeper, msg MsgSettleRedemption) sdkTypes.Result {
+	redemption, err := keeper.SettleRedemption(ctx, msg.BankAddress, msg.RedemptionID, msg.Reference)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			msg.Type(),
+			sdkTypes.NewAttribute(AttributeKeyRedeemer, redemption.RedeemerAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyBank, msg.BankAddress.String()),
+			sdkTypes.NewAttribute(AttributeKeyRedemptionID, strconv.FormatUint(redemption.RedemptionID, 10)),
+			sdkTypes.NewAttribute(AttributeKeyTransactionID, msg.Reference),
+		),
+	)
+	return sdkTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
@@ -1 +1,301 @@
-package asset
+package fiat
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/zone/access"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+const (
+	StoreKey     = "fiat"
+	QuerierRoute = "fiat"
+)
+
+var (
+	PegHashCounterKey      = []byte{0x00}
+	FiatPegKeyPrefix       = []byte{0x01}
+	OwnerFiatPegKeyPrefix  = []byte{0x02}
+	BankKeyPrefix          = []byte{0x03}
+	TransactionIDKeyPrefix = []byte{0x04}
+	RedemptionCounterKey   = []byte{0x05}
+	RedemptionKeyPrefix    = []byte{0x06}
+	ChainEscrowKeyPrefix   = []byte{0x07}
+)
+
+func GetFiatPegKey(pegHash types.PegHash) []byte {
+	return append(FiatPegKeyPrefix, pegHash.Bytes()...)
+}
+func GetOwnerFiatPegsKey(ownerAddress sdkTypes.AccAddress) []byte {
+	return append(OwnerFiatPegKeyPrefix, ownerAddress.Bytes()...)
+}
+func GetOwnerFiatPegKey(ownerAddress sdkTypes.AccAddress, pegHash types.PegHash) []byte {
+	return append(GetOwnerFiatPegsKey(ownerAddress), pegHash.Bytes()...)
+}
+func GetBankKey(pegHash types.PegHash) []byte {
+	return append(BankKeyPrefix, pegHash.Bytes()...)
+}
+func GetTransactionIDKey(transactionID string) []byte {
+	return append(TransactionIDKeyPrefix, []byte(transactionID)...)
+}
+func GetRedemptionKey(redemptionID uint64) []byte {
+	redemptionIDBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(redemptionIDBytes, redemptionID)
+	return append(RedemptionKeyPrefix, redemptionIDBytes...)
+}
+func GetChainEscrowKey(chainID string) []byte {
+	return append(ChainEscrowKeyPrefix, []byte(chainID)...)
+}
+
+type Keeper struct {
+	storeKey     sdkTypes.StoreKey
+	cdc          *codec.Codec
+	accessKeeper access.Keeper
+	codespace    sdkTypes.CodespaceType
+}
+
+func NewKeeper(cdc *codec.Codec, storeKey sdkTypes.StoreKey, accessKeeper access.Keeper, codespace sdkTypes.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:     storeKey,
+		cdc:          cdc,
+		accessKeeper: accessKeeper,
+		codespace:    codespace,
+	}
+}
+func (keeper Keeper) Codespace() sdkTypes.CodespaceType {
+	return keeper.codespace
+}
+func (keeper Keeper) getCounter(ctx sdkTypes.Context, counterKey []byte) uint64 {
+	store := ctx.KVStore(keeper.storeKey)
+	counterBytes := store.Get(counterKey)
+	if counterBytes == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(counterBytes)
+}
+func (keeper Keeper) setCounter(ctx sdkTypes.Context, counterKey []byte, counter uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	counterBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(counterBytes, counter)
+	store.Set(counterKey, counterBytes)
+}
+func (keeper Keeper) getNextCounter(ctx sdkTypes.Context, counterKey []byte) uint64 {
+	counter := keeper.getCounter(ctx, counterKey) + 1
+	keeper.setCounter(ctx, counterKey, counter)
+	return counter
+}
+func (keeper Keeper) getNextPegHash(ctx sdkTypes.Context) types.PegHash {
+	pegHash := make([]byte, 8)
+	binary.BigEndian.PutUint64(pegHash, keeper.getNextCounter(ctx, PegHashCounterKey))
+	return pegHash
+}
+func (keeper Keeper) GetFiatPeg(ctx sdkTypes.Context, pegHash types.PegHash) (types.BaseFiatPeg, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	fiatPegBytes := store.Get(GetFiatPegKey(pegHash))
+	if fiatPegBytes == nil {
+		return types.BaseFiatPeg{}, false
+	}
+
+	var fiatPeg types.BaseFiatPeg
+	keeper.cdc.MustUnmarshalBinaryBare(fiatPegBytes, &fiatPeg)
+	return fiatPeg, true
+}
+func (keeper Keeper) SetFiatPeg(ctx sdkTypes.Context, fiatPeg types.BaseFiatPeg) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	if oldFiatPeg, found := keeper.GetFiatPeg(ctx, fiatPeg.PegHash); found {
+		for _, owner := range oldFiatPeg.Owners {
+			store.Delete(GetOwnerFiatPegKey(owner.OwnerAddress, fiatPeg.PegHash))
+		}
+	}
+
+	store.Set(GetFiatPegKey(fiatPeg.PegHash), keeper.cdc.MustMarshalBinaryBare(fiatPeg))
+	for _, owner := range fiatPeg.Owners {
+		store.Set(GetOwnerFiatPegKey(owner.OwnerAddress, fiatPeg.PegHash), []byte{})
+	}
+}
+func (keeper Keeper) SetFiatPegWallet(ctx sdkTypes.Context, fiatPegWallet types.FiatPegWallet) {
+	for _, fiatPeg := range fiatPegWallet {
+		keeper.SetFiatPeg(ctx, fiatPeg)
+	}
+}
+func (keeper Keeper) GetFiatPegWallet(ctx sdkTypes.Context, ownerAddress sdkTypes.AccAddress) types.FiatPegWallet {
+	store := ctx.KVStore(keeper.storeKey)
+	ownerPrefix := GetOwnerFiatPegsKey(ownerAddress)
+	iterator := sdkTypes.KVStorePrefixIterator(store, ownerPrefix)
+	defer iterator.Close()
+
+	var fiatPegWallet types.FiatPegWallet
+	for ; iterator.Valid(); iterator.Next() {
+		pegHash := types.PegHash(iterator.Key()[len(ownerPrefix):])
+		if fiatPeg, found := keeper.GetFiatPeg(ctx, pegHash); found {
+			fiatPegWallet = append(fiatPegWallet, fiatPeg)
+		}
+	}
+	return fiatPegWallet.Sort()
+}
+func (keeper Keeper) IterateFiatPegs(ctx sdkTypes.Context, handler func(fiatPeg types.BaseFiatPeg) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdkTypes.KVStorePrefixIterator(store, FiatPegKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var fiatPeg types.BaseFiatPeg
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &fiatPeg)
+		if handler(fiatPeg) {
+			break
+		}
+	}
+}
+func (keeper Keeper) GetBank(ctx sdkTypes.Context, pegHash types.PegHash) sdkTypes.AccAddress {
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Get(GetBankKey(pegHash))
+}
+func (keeper Keeper) setBank(ctx sdkTypes.Context, fiatPeg types.BaseFiatPeg, bankAddress sdkTypes.AccAddress) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(GetBankKey(fiatPeg.PegHash), bankAddress.Bytes())
+	store.Set(GetTransactionIDKey(fiatPeg.TransactionID), fiatPeg.PegHash.Bytes())
+}
+func (keeper Keeper) GetRedemption(ctx sdkTypes.Context, redemptionID uint64) (Redemption, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	redemptionBytes := store.Get(GetRedemptionKey(redemptionID))
+	if redemptionBytes == nil {
+		return Redemption{}, false
+	}
+
+	var redemption Redemption
+	keeper.cdc.MustUnmarshalBinaryBare(redemptionBytes, &redemption)
+	return redemption, true
+}
+func (keeper Keeper) SetRedemption(ctx sdkTypes.Context, redemption Redemption) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(GetRedemptionKey(redemption.RedemptionID), keeper.cdc.MustMarshalBinaryBare(redemption))
+}
+func (keeper Keeper) IterateRedemptions(ctx sdkTypes.Context, handler func(redemption Redemption) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdkTypes.KVStorePrefixIterator(store, RedemptionKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var redemption Redemption
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &redemption)
+		if handler(redemption) {
+			break
+		}
+	}
+}
+func (keeper Keeper) GetChainEscrow(ctx sdkTypes.Context, chainID string) ChainEscrow {
+	store := ctx.KVStore(keeper.storeKey)
+	chainEscrowBytes := store.Get(GetChainEscrowKey(chainID))
+	if chainEscrowBytes == nil {
+		return NewChainEscrow(chainID, 0)
+	}
+
+	var chainEscrow ChainEscrow
+	keeper.cdc.MustUnmarshalBinaryBare(chainEscrowBytes, &chainEscrow)
+	return chainEscrow
+}
+func (keeper Keeper) SetChainEscrow(ctx sdkTypes.Context, chainEscrow ChainEscrow) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(GetChainEscrowKey(chainEscrow.ChainID), keeper.cdc.MustMarshalBinaryBare(chainEscrow))
+}
+func (keeper Keeper) IterateChainEscrows(ctx sdkTypes.Context, handler func(chainEscrow ChainEscrow) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdkTypes.KVStorePrefixIterator(store, ChainEscrowKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var chainEscrow ChainEscrow
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &chainEscrow)
+		if handler(chainEscrow) {
+			break
+		}
+	}
+}
+func (keeper Keeper) checkBank(ctx sdkTypes.Context, bankAddress sdkTypes.AccAddress, msgType string) sdkTypes.Error {
+	if !keeper.accessKeeper.HasRole(ctx, bankAddress, access.RoleBank) {
+		return access.ErrMissingRole(keeper.accessKeeper.Codespace(), bankAddress, msgType)
+	}
+	return nil
+}
+func (keeper Keeper) AttestDeposit(ctx sdkTypes.Context, bankAddress sdkTypes.AccAddress, depositorAddress sdkTypes.AccAddress, transactionID string, transactionAmount int64) (types.BaseFiatPeg, sdkTypes.Error) {
+	if err := keeper.checkBank(ctx, bankAddress, "attestDeposit"); err != nil {
+		return types.BaseFiatPeg{}, err
+	}
+	return keeper.mintFiat(ctx, bankAddress, depositorAddress, transactionID, transactionAmount)
+}
+func (keeper Keeper) mintFiat(ctx sdkTypes.Context, bankAddress sdkTypes.AccAddress, toAddress sdkTypes.AccAddress, transactionID string, transactionAmount int64) (types.BaseFiatPeg, sdkTypes.Error) {
+	store := ctx.KVStore(keeper.storeKey)
+	if store.Has(GetTransactionIDKey(transactionID)) {
+		return types.BaseFiatPeg{}, ErrTransactionIDExists(keeper.codespace, transactionID)
+	}
+
+	fiatPeg := types.NewBaseFiatPeg(keeper.getNextPegHash(ctx), transactionID, transactionAmount, toAddress)
+	if err := fiatPeg.ValidateBasic(); err != nil {
+		return types.BaseFiatPeg{}, err
+	}
+
+	keeper.SetFiatPeg(ctx, fiatPeg)
+	keeper.setBank(ctx, fiatPeg, bankAddress)
+	return fiatPeg, nil
+}
+func (keeper Keeper) SendFiat(ctx sdkTypes.Context, fromAddress sdkTypes.AccAddress, toAddress sdkTypes.AccAddress, amount int64) sdkTypes.Error {
+	fiatPegWallet, err := types.TransferAmountInWallet(keeper.GetFiatPegWallet(ctx, fromAddress), fromAddress, toAddress, amount)
+	if err != nil {
+		return err
+	}
+
+	keeper.SetFiatPegWallet(ctx, fiatPegWallet)
+	return nil
+}
+func (keeper Keeper) RequestRedemption(ctx sdkTypes.Context, redeemerAddress sdkTypes.AccAddress, bankAddress sdkTypes.AccAddress, amount int64) (Redemption, sdkTypes.Error) {
+	var bankFiatPegWallet types.FiatPegWallet
+	for _, fiatPeg := range keeper.GetFiatPegWallet(ctx, redeemerAddress) {
+		if keeper.GetBank(ctx, fiatPeg.PegHash).Equals(bankAddress) {
+			bankFiatPegWallet = append(bankFiatPegWallet, fiatPeg)
+		}
+	}
+
+	redeemedFiatPegWallet, remainingFiatPegWallet, err := types.SubtractAmountFromWallet(bankFiatPegWallet, redeemerAddress, amount)
+	if err != nil {
+		return Redemption{}, err
+	}
+
+	for _, redeemedFiatPeg := range redeemedFiatPegWallet {
+		fiatPeg, _ := remainingFiatPegWallet.GetFiatPeg(redeemedFiatPeg.PegHash)
+		redeemedAmount := fiatPeg.RedeemedAmount + redeemedFiatPeg.GetOwnerAmount(redeemerAddress)
+		if err := fiatPeg.SetRedeemedAmount(redeemedAmount); err != nil {
+			return Redemption{}, ErrInvalidAmount(keeper.codespace, fmt.Sprintf("cannot redeem fiat peg %s: %s", fiatPeg.PegHash, err.Error()))
+		}
+		keeper.SetFiatPeg(ctx, fiatPeg)
+	}
+
+	redemption := NewRedemption(keeper.getNextCounter(ctx, RedemptionCounterKey), redeemerAddress, bankAddress, amount)
+	keeper.SetRedemption(ctx, redemption)
+	return redemption, nil
+}
+func (keeper Keeper) SettleRedemption(ctx sdkTypes.Context, bankAddress sdkTypes.AccAddress, redemptionID uint64, reference string) (Redemption, sdkTypes.Error) {
+	if err := keeper.checkBank(ctx, bankAddress, "settleRedemption"); err != nil {
+		return Redemption{}, err
+	}
+
+	redemption, found := keeper.GetRedemption(ctx, redemptionID)
+	if !found {
+		return Redemption{}, ErrRedemptionNotFound(keeper.codespace, redemptionID)
+	}
+	if !redemption.BankAddress.Equals(bankAddress) {
+		return Redemption{}, ErrUnauthorizedBank(keeper.codespace, bankAddress, redemptionID)
+	}
+	if redemption.Status != RedemptionPending {
+		return Redemption{}, ErrRedemptionSettled(keeper.codespace, redemptionID)
+	}
+
+	redemption.Reference = reference
+	redemption.Status = RedemptionSettled
+	keeper.SetRedemption(ctx, redemption)
+	return redemption, nil
+}
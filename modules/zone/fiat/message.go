@@ -1 +1,169 @@
-package asset
+package fiat
+
+import (
+	"strings"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/zone/access"
+)
+
+const RouterKey = "fiat"
+
+type MsgAttestDeposit struct {
+	BankAddress       sdkTypes.AccAddress `json:"bankAddress"`
+	DepositorAddress  sdkTypes.AccAddress `json:"depositorAddress"`
+	TransactionID     string              `json:"transactionID"`
+	TransactionAmount int64               `json:"transactionAmount"`
+}
+
+var _ access.RoleRestrictedMsg = MsgAttestDeposit{}
+
+func NewMsgAttestDeposit(bankAddress sdkTypes.AccAddress, depositorAddress sdkTypes.AccAddress, transactionID string, transactionAmount int64) MsgAttestDeposit {
+	return MsgAttestDeposit{
+		BankAddress:       bankAddress,
+		DepositorAddress:  depositorAddress,
+		TransactionID:     transactionID,
+		TransactionAmount: transactionAmount,
+	}
+}
+func (msg MsgAttestDeposit) Route() string { return RouterKey }
+func (msg MsgAttestDeposit) Type() string  { return "attestDeposit" }
+func (msg MsgAttestDeposit) ValidateBasic() sdkTypes.Error {
+	if msg.BankAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing bank address")
+	}
+	if msg.DepositorAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing depositor address")
+	}
+	if len(strings.TrimSpace(msg.TransactionID)) == 0 {
+		return ErrInvalidTransactionID(DefaultCodespace)
+	}
+	if msg.TransactionAmount <= 0 {
+		return ErrInvalidAmount(DefaultCodespace, "transaction amount must be positive")
+	}
+	return nil
+}
+func (msg MsgAttestDeposit) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+func (msg MsgAttestDeposit) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.BankAddress}
+}
+func (msg MsgAttestDeposit) GetRequiredRoles() []access.Role {
+	return []access.Role{access.RoleBank}
+}
+
+type MsgSendFiat struct {
+	FromAddress sdkTypes.AccAddress `json:"fromAddress"`
+	ToAddress   sdkTypes.AccAddress `json:"toAddress"`
+	Amount      int64               `json:"amount"`
+}
+
+var _ access.RoleRestrictedMsg = MsgSendFiat{}
+
+func NewMsgSendFiat(fromAddress sdkTypes.AccAddress, toAddress sdkTypes.AccAddress, amount int64) MsgSendFiat {
+	return MsgSendFiat{
+		FromAddress: fromAddress,
+		ToAddress:   toAddress,
+		Amount:      amount,
+	}
+}
+func (msg MsgSendFiat) Route() string { return RouterKey }
+func (msg MsgSendFiat) Type() string  { return "sendFiat" }
+func (msg MsgSendFiat) ValidateBasic() sdkTypes.Error {
+	if msg.FromAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing sender address")
+	}
+	if msg.ToAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing recipient address")
+	}
+	if msg.Amount <= 0 {
+		return ErrInvalidAmount(DefaultCodespace, "send amount must be positive")
+	}
+	return nil
+}
+func (msg MsgSendFiat) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+func (msg MsgSendFiat) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.FromAddress}
+}
+func (msg MsgSendFiat) GetRequiredRoles() []access.Role {
+	return []access.Role{access.RoleTrader, access.RoleBank}
+}
+
+type MsgRequestRedemption struct {
+	RedeemerAddress sdkTypes.AccAddress `json:"redeemerAddress"`
+	BankAddress     sdkTypes.AccAddress `json:"bankAddress"`
+	Amount          int64               `json:"amount"`
+}
+
+var _ access.RoleRestrictedMsg = MsgRequestRedemption{}
+
+func NewMsgRequestRedemption(redeemerAddress sdkTypes.AccAddress, bankAddress sdkTypes.AccAddress, amount int64) MsgRequestRedemption {
+	return MsgRequestRedemption{
+		RedeemerAddress: redeemerAddress,
+		BankAddress:     bankAddress,
+		Amount:          amount,
+	}
+}
+func (msg MsgRequestRedemption) Route() string { return RouterKey }
+func (msg MsgRequestRedemption) Type() string  { return "requestRedemption" }
+func (msg MsgRequestRedemption) ValidateBasic() sdkTypes.Error {
+	if msg.RedeemerAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing redeemer address")
+	}
+	if msg.BankAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing bank address")
+	}
+	if msg.Amount <= 0 {
+		return ErrInvalidAmount(DefaultCodespace, "redeem amount must be positive")
+	}
+	return nil
+}
+func (msg MsgRequestRedemption) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+func (msg MsgRequestRedemption) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.RedeemerAddress}
+}
+func (msg MsgRequestRedemption) GetRequiredRoles() []access.Role {
+	return []access.Role{access.RoleTrader}
+}
+
+type MsgSettleRedemption struct {
+	BankAddress  sdkTypes.AccAddress `json:"bankAddress"`
+	RedemptionID uint64              `json:"redemptionID"`
+	Reference    string              `json:"reference"`
+}
+
+var _ access.RoleRestrictedMsg = MsgSettleRedemption{}
+
+func NewMsgSettleRedemption(bankAddress sdkTypes.AccAddress, redemptionID uint64, reference string) MsgSettleRedemption {
+	return MsgSettleRedemption{
+		BankAddress:  bankAddress,
+		RedemptionID: redemptionID,
+		Reference:    reference,
+	}
+}
+func (msg MsgSettleRedemption) Route() string { return RouterKey }
+func (msg MsgSettleRedemption) Type() string  { return "settleRedemption" }
+func (msg MsgSettleRedemption) ValidateBasic() sdkTypes.Error {
+	if msg.BankAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("missing bank address")
+	}
+	if len(strings.TrimSpace(msg.Reference)) == 0 {
+		return ErrInvalidTransactionID(DefaultCodespace)
+	}
+	return nil
+}
+func (msg MsgSettleRedemption) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+func (msg MsgSettleRedemption) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.BankAddress}
+}
+func (msg MsgSettleRedemption) GetRequiredRoles() []access.Role {
+	return []access.Role{access.RoleBank}
+}
@@ -1 +1,86 @@
-package asset
+package fiat
+
+import (
+	"fmt"
+
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	QueryOwnerFiats  = "ownerFiats"
+	QueryRedemptions = "redemptions"
+)
+
+type QueryOwnerFiatsParams struct {
+	OwnerAddress sdkTypes.AccAddress `json:"ownerAddress"`
+}
+
+func NewQueryOwnerFiatsParams(ownerAddress sdkTypes.AccAddress) QueryOwnerFiatsParams {
+	return QueryOwnerFiatsParams{
+		OwnerAddress: ownerAddress,
+	}
+}
+
+type QueryRedemptionsParams struct {
+	BankAddress sdkTypes.AccAddress `json:"bankAddress"`
+	PendingOnly bool                `json:"pendingOnly"`
+}
+
+func NewQueryRedemptionsParams(bankAddress sdkTypes.AccAddress, pendingOnly bool) QueryRedemptionsParams {
+	return QueryRedemptionsParams{
+		BankAddress: bankAddress,
+		PendingOnly: pendingOnly,
+	}
+}
+
+func NewQuerier(keeper Keeper) sdkTypes.Querier {
+	return func(ctx sdkTypes.Context, path []string, req abciTypes.RequestQuery) ([]byte, sdkTypes.Error) {
+		switch path[0] {
+		case QueryOwnerFiats:
+			return queryOwnerFiats(ctx, req, keeper)
+		case QueryRedemptions:
+			return queryRedemptions(ctx, req, keeper)
+		default:
+			return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("unknown zone fiat query endpoint: %s", path[0]))
+		}
+	}
+}
+func queryOwnerFiats(ctx sdkTypes.Context, req abciTypes.RequestQuery, keeper Keeper) ([]byte, sdkTypes.Error) {
+	var params QueryOwnerFiatsParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkTypes.ErrUnknownRequest(sdkTypes.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetFiatPegWallet(ctx, params.OwnerAddress))
+	if err != nil {
+		return nil, sdkTypes.ErrInternal(sdkTypes.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+	return res, nil
+}
+func queryRedemptions(ctx sdkTypes.Context, req abciTypes.RequestQuery, keeper Keeper) ([]byte, sdkTypes.Error) {
+	var params QueryRedemptionsParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkTypes.ErrUnknownRequest(sdkTypes.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	redemptions := []Redemption{}
+	keeper.IterateRedemptions(ctx, func(redemption Redemption) (stop bool) {
+		if !params.BankAddress.Empty() && !redemption.BankAddress.Equals(params.BankAddress) {
+			return false
+		}
+		if params.PendingOnly && redemption.Status != RedemptionPending {
+			return false
+		}
+		redemptions = append(redemptions, redemption)
+		return false
+	})
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, redemptions)
+	if err != nil {
+		return nil, sdkTypes.ErrInternal(sdkTypes.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+	return res, nil
+}
@@ -0,0 +1,56 @@
+package fiat
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+type RedemptionStatus byte
+
+const (
+	RedemptionPending RedemptionStatus = 0x00
+	RedemptionSettled RedemptionStatus = 0x01
+)
+
+func (status RedemptionStatus) String() string {
+	switch status {
+	case RedemptionPending:
+		return "Pending"
+	case RedemptionSettled:
+		return "Settled"
+	default:
+		return ""
+	}
+}
+
+type Redemption struct {
+	RedemptionID    uint64              `json:"redemptionID"`
+	RedeemerAddress sdkTypes.AccAddress `json:"redeemerAddress"`
+	BankAddress     sdkTypes.AccAddress `json:"bankAddress"`
+	Amount          int64               `json:"amount"`
+	Reference       string              `json:"reference"`
+	Status          RedemptionStatus    `json:"status"`
+}
+
+func NewRedemption(redemptionID uint64, redeemerAddress sdkTypes.AccAddress, bankAddress sdkTypes.AccAddress, amount int64) Redemption {
+	return Redemption{
+		RedemptionID:    redemptionID,
+		RedeemerAddress: redeemerAddress,
+		BankAddress:     bankAddress,
+		Amount:          amount,
+		Status:          RedemptionPending,
+	}
+}
+func (redemption Redemption) String() string {
+	return fmt.Sprintf(`Redemption:
+  RedemptionID:    %d
+  RedeemerAddress: %s
+  BankAddress:     %s
+  Amount:          %d
+  Reference:       %s
+  Status:          %s`,
+		redemption.RedemptionID, redemption.RedeemerAddress, redemption.BankAddress,
+		redemption.Amount, redemption.Reference, redemption.Status,
+	)
+}
@@ -1 +1,201 @@
 package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+type PegHash []byte
+
+func GetPegHashHex(pegHashString string) (PegHash, error) {
+	if len(strings.TrimSpace(pegHashString)) == 0 {
+		return PegHash{}, errors.New("decoding peg hash failed: must provide a peg hash")
+	}
+
+	pegHashBytes, err := hex.DecodeString(pegHashString)
+	if err != nil {
+		return nil, err
+	}
+	return PegHash(pegHashBytes), nil
+}
+func (pegHash PegHash) Empty() bool {
+	return len(pegHash) == 0
+}
+func (pegHash PegHash) Equals(otherPegHash PegHash) bool {
+	return bytes.Equal(pegHash, otherPegHash)
+}
+func (pegHash PegHash) Bytes() []byte {
+	return pegHash
+}
+func (pegHash PegHash) String() string {
+	return strings.ToUpper(hex.EncodeToString(pegHash))
+}
+func (pegHash PegHash) MarshalJSON() ([]byte, error) {
+	return json.Marshal(pegHash.String())
+}
+func (pegHash *PegHash) UnmarshalJSON(data []byte) error {
+	var pegHashString string
+	if err := json.Unmarshal(data, &pegHashString); err != nil {
+		return err
+	}
+
+	if pegHashString == "" {
+		*pegHash = PegHash{}
+		return nil
+	}
+
+	decodedPegHash, err := GetPegHashHex(pegHashString)
+	if err != nil {
+		return err
+	}
+	*pegHash = decodedPegHash
+	return nil
+}
+
+type AssetPeg interface {
+	GetPegHash() PegHash
+	SetPegHash(PegHash) error
+
+	GetDocumentHash() string
+	SetDocumentHash(string) error
+
+	GetAssetType() string
+	SetAssetType(string) error
+
+	GetAssetQuantity() int64
+	SetAssetQuantity(int64) error
+
+	GetQuantityUnit() string
+	SetQuantityUnit(string) error
+
+	GetOwnerAddress() sdkTypes.AccAddress
+	SetOwnerAddress(sdkTypes.AccAddress) error
+
+	GetLocked() bool
+	SetLocked(bool) error
+
+	ValidateBasic() sdkTypes.Error
+
+	String() string
+}
+
+var _ AssetPeg = (*BaseAssetPeg)(nil)
+
+type BaseAssetPeg struct {
+	PegHash       PegHash             `json:"pegHash"`
+	DocumentHash  string              `json:"documentHash"`
+	AssetType     string              `json:"assetType"`
+	AssetQuantity int64               `json:"assetQuantity"`
+	QuantityUnit  string              `json:"quantityUnit"`
+	OwnerAddress  sdkTypes.AccAddress `json:"ownerAddress"`
+	Locked        bool                `json:"locked"`
+}
+
+func NewBaseAssetPeg(pegHash PegHash, documentHash string, assetType string, assetQuantity int64, quantityUnit string, ownerAddress sdkTypes.AccAddress) BaseAssetPeg {
+	return BaseAssetPeg{
+		PegHash:       pegHash,
+		DocumentHash:  documentHash,
+		AssetType:     assetType,
+		AssetQuantity: assetQuantity,
+		QuantityUnit:  quantityUnit,
+		OwnerAddress:  ownerAddress,
+	}
+}
+func ProtoBaseAssetPeg() AssetPeg {
+	return &BaseAssetPeg{}
+}
+func (baseAssetPeg BaseAssetPeg) GetPegHash() PegHash {
+	return baseAssetPeg.PegHash
+}
+func (baseAssetPeg *BaseAssetPeg) SetPegHash(pegHash PegHash) error {
+	if !baseAssetPeg.PegHash.Empty() {
+		return errors.New("cannot override BaseAssetPeg peg hash")
+	}
+	baseAssetPeg.PegHash = pegHash
+	return nil
+}
+func (baseAssetPeg BaseAssetPeg) GetDocumentHash() string {
+	return baseAssetPeg.DocumentHash
+}
+func (baseAssetPeg *BaseAssetPeg) SetDocumentHash(documentHash string) error {
+	baseAssetPeg.DocumentHash = documentHash
+	return nil
+}
+func (baseAssetPeg BaseAssetPeg) GetAssetType() string {
+	return baseAssetPeg.AssetType
+}
+func (baseAssetPeg *BaseAssetPeg) SetAssetType(assetType string) error {
+	baseAssetPeg.AssetType = assetType
+	return nil
+}
+func (baseAssetPeg BaseAssetPeg) GetAssetQuantity() int64 {
+	return baseAssetPeg.AssetQuantity
+}
+func (baseAssetPeg *BaseAssetPeg) SetAssetQuantity(assetQuantity int64) error {
+	if assetQuantity < 0 {
+		return errors.New("asset quantity cannot be negative")
+	}
+	baseAssetPeg.AssetQuantity = assetQuantity
+	return nil
+}
+func (baseAssetPeg BaseAssetPeg) GetQuantityUnit() string {
+	return baseAssetPeg.QuantityUnit
+}
+func (baseAssetPeg *BaseAssetPeg) SetQuantityUnit(quantityUnit string) error {
+	baseAssetPeg.QuantityUnit = quantityUnit
+	return nil
+}
+func (baseAssetPeg BaseAssetPeg) GetOwnerAddress() sdkTypes.AccAddress {
+	return baseAssetPeg.OwnerAddress
+}
+func (baseAssetPeg *BaseAssetPeg) SetOwnerAddress(ownerAddress sdkTypes.AccAddress) error {
+	baseAssetPeg.OwnerAddress = ownerAddress
+	return nil
+}
+func (baseAssetPeg BaseAssetPeg) GetLocked() bool {
+	return baseAssetPeg.Locked
+}
+func (baseAssetPeg *BaseAssetPeg) SetLocked(locked bool) error {
+	baseAssetPeg.Locked = locked
+	return nil
+}
+func (baseAssetPeg BaseAssetPeg) ValidateBasic() sdkTypes.Error {
+	if baseAssetPeg.PegHash.Empty() {
+		return ErrInvalidPegHash("peg hash cannot be empty")
+	}
+	if len(strings.TrimSpace(baseAssetPeg.DocumentHash)) == 0 {
+		return ErrInvalidAssetPeg("document hash cannot be empty")
+	}
+	if len(strings.TrimSpace(baseAssetPeg.AssetType)) == 0 {
+		return ErrInvalidAssetPeg("asset type cannot be empty")
+	}
+	if baseAssetPeg.AssetQuantity <= 0 {
+		return ErrInvalidAssetPeg("asset quantity must be positive")
+	}
+	if len(strings.TrimSpace(baseAssetPeg.QuantityUnit)) == 0 {
+		return ErrInvalidAssetPeg("quantity unit cannot be empty")
+	}
+	if baseAssetPeg.OwnerAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("owner address cannot be empty")
+	}
+	return nil
+}
+func (baseAssetPeg BaseAssetPeg) String() string {
+	return fmt.Sprintf(`AssetPeg:
+  PegHash:       %s
+  DocumentHash:  %s
+  AssetType:     %s
+  AssetQuantity: %d
+  QuantityUnit:  %s
+  OwnerAddress:  %s
+  Locked:        %t`,
+		baseAssetPeg.PegHash, baseAssetPeg.DocumentHash, baseAssetPeg.AssetType, baseAssetPeg.AssetQuantity,
+		baseAssetPeg.QuantityUnit, baseAssetPeg.OwnerAddress, baseAssetPeg.Locked,
+	)
+}
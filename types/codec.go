@@ -0,0 +1,12 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterInterface((*AssetPeg)(nil), nil)
+	cdc.RegisterConcrete(&BaseAssetPeg{}, "commit/AssetPeg", nil)
+	cdc.RegisterInterface((*FiatPeg)(nil), nil)
+	cdc.RegisterConcrete(&BaseFiatPeg{}, "commit/FiatPeg", nil)
+}
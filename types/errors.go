@@ -0,0 +1,27 @@
+package types
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdkTypes.CodespaceType = "commit"
+
+	CodeInvalidPegHash   sdkTypes.CodeType = 101
+	CodeInvalidAssetPeg  sdkTypes.CodeType = 102
+	CodeInvalidFiatPeg   sdkTypes.CodeType = 103
+	CodeInsufficientFiat sdkTypes.CodeType = 104
+)
+
+func ErrInvalidPegHash(message string) sdkTypes.Error {
+	return sdkTypes.NewError(DefaultCodespace, CodeInvalidPegHash, message)
+}
+func ErrInvalidAssetPeg(message string) sdkTypes.Error {
+	return sdkTypes.NewError(DefaultCodespace, CodeInvalidAssetPeg, message)
+}
+func ErrInvalidFiatPeg(message string) sdkTypes.Error {
+	return sdkTypes.NewError(DefaultCodespace, CodeInvalidFiatPeg, message)
+}
+func ErrInsufficientFiat(message string) sdkTypes.Error {
+	return sdkTypes.NewError(DefaultCodespace, CodeInsufficientFiat, message)
+}
@@ -1 +1,340 @@
 package types
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+type Owner struct {
+	OwnerAddress sdkTypes.AccAddress `json:"ownerAddress"`
+	Amount       int64               `json:"amount"`
+}
+
+func NewOwner(ownerAddress sdkTypes.AccAddress, amount int64) Owner {
+	return Owner{
+		OwnerAddress: ownerAddress,
+		Amount:       amount,
+	}
+}
+
+type FiatPeg interface {
+	GetPegHash() PegHash
+	SetPegHash(PegHash) error
+
+	GetTransactionID() string
+	SetTransactionID(string) error
+
+	GetTransactionAmount() int64
+	SetTransactionAmount(int64) error
+
+	GetRedeemedAmount() int64
+	SetRedeemedAmount(int64) error
+
+	GetOwners() []Owner
+	SetOwners([]Owner) error
+
+	ValidateBasic() sdkTypes.Error
+
+	String() string
+}
+
+var _ FiatPeg = (*BaseFiatPeg)(nil)
+
+type BaseFiatPeg struct {
+	PegHash           PegHash `json:"pegHash"`
+	TransactionID     string  `json:"transactionID"`
+	TransactionAmount int64   `json:"transactionAmount"`
+	RedeemedAmount    int64   `json:"redeemedAmount"`
+	Owners            []Owner `json:"owners"`
+}
+
+func NewBaseFiatPeg(pegHash PegHash, transactionID string, transactionAmount int64, ownerAddress sdkTypes.AccAddress) BaseFiatPeg {
+	return BaseFiatPeg{
+		PegHash:           pegHash,
+		TransactionID:     transactionID,
+		TransactionAmount: transactionAmount,
+		Owners:            []Owner{NewOwner(ownerAddress, transactionAmount)},
+	}
+}
+func ProtoBaseFiatPeg() FiatPeg {
+	return &BaseFiatPeg{}
+}
+func (baseFiatPeg BaseFiatPeg) GetPegHash() PegHash {
+	return baseFiatPeg.PegHash
+}
+func (baseFiatPeg *BaseFiatPeg) SetPegHash(pegHash PegHash) error {
+	if !baseFiatPeg.PegHash.Empty() {
+		return errors.New("cannot override BaseFiatPeg peg hash")
+	}
+	baseFiatPeg.PegHash = pegHash
+	return nil
+}
+func (baseFiatPeg BaseFiatPeg) GetTransactionID() string {
+	return baseFiatPeg.TransactionID
+}
+func (baseFiatPeg *BaseFiatPeg) SetTransactionID(transactionID string) error {
+	baseFiatPeg.TransactionID = transactionID
+	return nil
+}
+func (baseFiatPeg BaseFiatPeg) GetTransactionAmount() int64 {
+	return baseFiatPeg.TransactionAmount
+}
+func (baseFiatPeg *BaseFiatPeg) SetTransactionAmount(transactionAmount int64) error {
+	if transactionAmount < 0 {
+		return errors.New("transaction amount cannot be negative")
+	}
+	baseFiatPeg.TransactionAmount = transactionAmount
+	return nil
+}
+func (baseFiatPeg BaseFiatPeg) GetRedeemedAmount() int64 {
+	return baseFiatPeg.RedeemedAmount
+}
+func (baseFiatPeg *BaseFiatPeg) SetRedeemedAmount(redeemedAmount int64) error {
+	if redeemedAmount < 0 || redeemedAmount > baseFiatPeg.TransactionAmount {
+		return errors.New("redeemed amount must lie between zero and the transaction amount")
+	}
+	baseFiatPeg.RedeemedAmount = redeemedAmount
+	return nil
+}
+func (baseFiatPeg BaseFiatPeg) GetOwners() []Owner {
+	return baseFiatPeg.Owners
+}
+func (baseFiatPeg *BaseFiatPeg) SetOwners(owners []Owner) error {
+	baseFiatPeg.Owners = owners
+	return nil
+}
+func (baseFiatPeg BaseFiatPeg) GetOwnerAmount(ownerAddress sdkTypes.AccAddress) int64 {
+	for _, owner := range baseFiatPeg.Owners {
+		if owner.OwnerAddress.Equals(ownerAddress) {
+			return owner.Amount
+		}
+	}
+	return 0
+}
+func (baseFiatPeg BaseFiatPeg) GetOutstandingAmount() int64 {
+	var outstandingAmount int64
+	for _, owner := range baseFiatPeg.Owners {
+		outstandingAmount += owner.Amount
+	}
+	return outstandingAmount
+}
+func (baseFiatPeg *BaseFiatPeg) AddOwnerAmount(ownerAddress sdkTypes.AccAddress, amount int64) sdkTypes.Error {
+	if amount <= 0 {
+		return ErrInvalidFiatPeg("amount must be positive")
+	}
+	for i, owner := range baseFiatPeg.Owners {
+		if owner.OwnerAddress.Equals(ownerAddress) {
+			baseFiatPeg.Owners[i].Amount += amount
+			return nil
+		}
+	}
+	baseFiatPeg.Owners = append(baseFiatPeg.Owners, NewOwner(ownerAddress, amount))
+	return nil
+}
+func (baseFiatPeg *BaseFiatPeg) SubtractOwnerAmount(ownerAddress sdkTypes.AccAddress, amount int64) sdkTypes.Error {
+	if amount <= 0 {
+		return ErrInvalidFiatPeg("amount must be positive")
+	}
+	for i, owner := range baseFiatPeg.Owners {
+		if owner.OwnerAddress.Equals(ownerAddress) {
+			if owner.Amount < amount {
+				return ErrInsufficientFiat(fmt.Sprintf("%s holds %d of fiat peg %s, needs %d", ownerAddress, owner.Amount, baseFiatPeg.PegHash, amount))
+			}
+			baseFiatPeg.Owners[i].Amount -= amount
+			if baseFiatPeg.Owners[i].Amount == 0 {
+				baseFiatPeg.Owners = append(baseFiatPeg.Owners[:i], baseFiatPeg.Owners[i+1:]...)
+			}
+			return nil
+		}
+	}
+	return ErrInsufficientFiat(fmt.Sprintf("%s holds no share of fiat peg %s", ownerAddress, baseFiatPeg.PegHash))
+}
+func (baseFiatPeg *BaseFiatPeg) TransferOwnership(fromAddress sdkTypes.AccAddress, toAddress sdkTypes.AccAddress, amount int64) sdkTypes.Error {
+	if err := baseFiatPeg.SubtractOwnerAmount(fromAddress, amount); err != nil {
+		return err
+	}
+	return baseFiatPeg.AddOwnerAmount(toAddress, amount)
+}
+func (baseFiatPeg BaseFiatPeg) ValidateBasic() sdkTypes.Error {
+	if baseFiatPeg.PegHash.Empty() {
+		return ErrInvalidPegHash("peg hash cannot be empty")
+	}
+	if len(strings.TrimSpace(baseFiatPeg.TransactionID)) == 0 {
+		return ErrInvalidFiatPeg("transaction id cannot be empty")
+	}
+	if baseFiatPeg.TransactionAmount <= 0 {
+		return ErrInvalidFiatPeg("transaction amount must be positive")
+	}
+	if baseFiatPeg.RedeemedAmount < 0 {
+		return ErrInvalidFiatPeg("redeemed amount cannot be negative")
+	}
+
+	ownerMap := make(map[string]bool, len(baseFiatPeg.Owners))
+	for _, owner := range baseFiatPeg.Owners {
+		if owner.OwnerAddress.Empty() {
+			return sdkTypes.ErrInvalidAddress("owner address cannot be empty")
+		}
+		if owner.Amount <= 0 {
+			return ErrInvalidFiatPeg(fmt.Sprintf("owner %s must hold a positive amount", owner.OwnerAddress))
+		}
+		if ownerMap[owner.OwnerAddress.String()] {
+			return ErrInvalidFiatPeg(fmt.Sprintf("duplicate owner %s", owner.OwnerAddress))
+		}
+		ownerMap[owner.OwnerAddress.String()] = true
+	}
+
+	if baseFiatPeg.GetOutstandingAmount()+baseFiatPeg.RedeemedAmount != baseFiatPeg.TransactionAmount {
+		return ErrInvalidFiatPeg("owned and redeemed amounts must add up to the transaction amount")
+	}
+	return nil
+}
+func (baseFiatPeg BaseFiatPeg) String() string {
+	var ownersString strings.Builder
+	for _, owner := range baseFiatPeg.Owners {
+		ownersString.WriteString(fmt.Sprintf("\n    %s: %d", owner.OwnerAddress, owner.Amount))
+	}
+
+	return fmt.Sprintf(`FiatPeg:
+  PegHash:           %s
+  TransactionID:     %s
+  TransactionAmount: %d
+  RedeemedAmount:    %d
+  Owners:%s`,
+		baseFiatPeg.PegHash, baseFiatPeg.TransactionID, baseFiatPeg.TransactionAmount,
+		baseFiatPeg.RedeemedAmount, ownersString.String(),
+	)
+}
+
+type FiatPegWallet []BaseFiatPeg
+
+func (fiatPegWallet FiatPegWallet) Len() int { return len(fiatPegWallet) }
+func (fiatPegWallet FiatPegWallet) Less(i, j int) bool {
+	return fiatPegWallet[i].PegHash.String() < fiatPegWallet[j].PegHash.String()
+}
+func (fiatPegWallet FiatPegWallet) Swap(i, j int) {
+	fiatPegWallet[i], fiatPegWallet[j] = fiatPegWallet[j], fiatPegWallet[i]
+}
+
+var _ sort.Interface = FiatPegWallet{}
+
+func (fiatPegWallet FiatPegWallet) Sort() FiatPegWallet {
+	sortedFiatPegWallet := append(FiatPegWallet(nil), fiatPegWallet...)
+	sort.Sort(sortedFiatPegWallet)
+	return sortedFiatPegWallet
+}
+func (fiatPegWallet FiatPegWallet) GetFiatPeg(pegHash PegHash) (BaseFiatPeg, bool) {
+	for _, fiatPeg := range fiatPegWallet {
+		if fiatPeg.PegHash.Equals(pegHash) {
+			return fiatPeg, true
+		}
+	}
+	return BaseFiatPeg{}, false
+}
+func (fiatPegWallet FiatPegWallet) AmountOf(ownerAddress sdkTypes.AccAddress) int64 {
+	var amount int64
+	for _, fiatPeg := range fiatPegWallet {
+		amount += fiatPeg.GetOwnerAmount(ownerAddress)
+	}
+	return amount
+}
+func (fiatPegWallet FiatPegWallet) Amount() int64 {
+	var amount int64
+	for _, fiatPeg := range fiatPegWallet {
+		amount += fiatPeg.GetOutstandingAmount()
+	}
+	return amount
+}
+func (fiatPegWallet FiatPegWallet) ValidateBasic() sdkTypes.Error {
+	pegHashMap := make(map[string]bool, len(fiatPegWallet))
+	for _, fiatPeg := range fiatPegWallet {
+		if err := fiatPeg.ValidateBasic(); err != nil {
+			return err
+		}
+		if pegHashMap[fiatPeg.PegHash.String()] {
+			return ErrInvalidFiatPeg(fmt.Sprintf("duplicate fiat peg %s in wallet", fiatPeg.PegHash))
+		}
+		pegHashMap[fiatPeg.PegHash.String()] = true
+	}
+	return nil
+}
+
+func AddFiatPegToWallet(fiatPegWallet FiatPegWallet, fiatPeg BaseFiatPeg) (FiatPegWallet, sdkTypes.Error) {
+	updatedFiatPegWallet := append(FiatPegWallet(nil), fiatPegWallet...)
+	for i, walletFiatPeg := range updatedFiatPegWallet {
+		if walletFiatPeg.PegHash.Equals(fiatPeg.PegHash) {
+			updatedFiatPegWallet[i].Owners = append([]Owner(nil), walletFiatPeg.Owners...)
+			for _, owner := range fiatPeg.Owners {
+				if err := updatedFiatPegWallet[i].AddOwnerAmount(owner.OwnerAddress, owner.Amount); err != nil {
+					return fiatPegWallet, err
+				}
+			}
+			return updatedFiatPegWallet, nil
+		}
+	}
+	return append(updatedFiatPegWallet, fiatPeg).Sort(), nil
+}
+func AddFiatPegWallets(fiatPegWallet FiatPegWallet, addedFiatPegWallet FiatPegWallet) (FiatPegWallet, sdkTypes.Error) {
+	updatedFiatPegWallet := fiatPegWallet
+	for _, fiatPeg := range addedFiatPegWallet {
+		var err sdkTypes.Error
+		if updatedFiatPegWallet, err = AddFiatPegToWallet(updatedFiatPegWallet, fiatPeg); err != nil {
+			return fiatPegWallet, err
+		}
+	}
+	return updatedFiatPegWallet, nil
+}
+
+// SubtractAmountFromWallet removes amount held by ownerAddress, walking the wallet in peg hash order, and returns
+// the pegs taken, each carrying a single owner entry for the subtracted share, alongside the remaining wallet.
+func SubtractAmountFromWallet(fiatPegWallet FiatPegWallet, ownerAddress sdkTypes.AccAddress, amount int64) (FiatPegWallet, FiatPegWallet, sdkTypes.Error) {
+	if amount <= 0 {
+		return nil, fiatPegWallet, ErrInvalidFiatPeg("amount must be positive")
+	}
+	if fiatPegWallet.AmountOf(ownerAddress) < amount {
+		return nil, fiatPegWallet, ErrInsufficientFiat(fmt.Sprintf("%s holds %d, needs %d", ownerAddress, fiatPegWallet.AmountOf(ownerAddress), amount))
+	}
+
+	var subtractedFiatPegWallet FiatPegWallet
+	var remainingFiatPegWallet FiatPegWallet
+	for _, fiatPeg := range fiatPegWallet.Sort() {
+		fiatPeg.Owners = append([]Owner(nil), fiatPeg.Owners...)
+		ownerAmount := fiatPeg.GetOwnerAmount(ownerAddress)
+		if amount > 0 && ownerAmount > 0 {
+			subtractedAmount := ownerAmount
+			if subtractedAmount > amount {
+				subtractedAmount = amount
+			}
+			if err := fiatPeg.SubtractOwnerAmount(ownerAddress, subtractedAmount); err != nil {
+				return nil, fiatPegWallet, err
+			}
+
+			subtractedFiatPeg := fiatPeg
+			subtractedFiatPeg.Owners = []Owner{NewOwner(ownerAddress, subtractedAmount)}
+			subtractedFiatPegWallet = append(subtractedFiatPegWallet, subtractedFiatPeg)
+			amount -= subtractedAmount
+		}
+		remainingFiatPegWallet = append(remainingFiatPegWallet, fiatPeg)
+	}
+	return subtractedFiatPegWallet, remainingFiatPegWallet, nil
+}
+
+// TransferAmountInWallet moves amount from one owner to another across the pegs of the wallet, splitting pegs
+// between the two owners where a single peg does not cover the amount.
+func TransferAmountInWallet(fiatPegWallet FiatPegWallet, fromAddress sdkTypes.AccAddress, toAddress sdkTypes.AccAddress, amount int64) (FiatPegWallet, sdkTypes.Error) {
+	subtractedFiatPegWallet, remainingFiatPegWallet, err := SubtractAmountFromWallet(fiatPegWallet, fromAddress, amount)
+	if err != nil {
+		return fiatPegWallet, err
+	}
+
+	for _, subtractedFiatPeg := range subtractedFiatPegWallet {
+		subtractedFiatPeg.Owners = []Owner{NewOwner(toAddress, subtractedFiatPeg.GetOwnerAmount(fromAddress))}
+		if remainingFiatPegWallet, err = AddFiatPegToWallet(remainingFiatPegWallet, subtractedFiatPeg); err != nil {
+			return fiatPegWallet, err
+		}
+	}
+	return remainingFiatPegWallet, nil
+}
@@ -0,0 +1,143 @@
+package types
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+type testOwnerAmount struct {
+	pegHash string
+	amount  int64
+}
+
+func newTestAddress() sdkTypes.AccAddress {
+	return sdkTypes.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+}
+
+// newTestWallet holds 10, 20 and 30 of pegs a, b and c for ownerAddress, out of peg hash order.
+func newTestWallet(ownerAddress sdkTypes.AccAddress) FiatPegWallet {
+	return FiatPegWallet{
+		NewBaseFiatPeg(PegHash("c"), "transaction-c", 30, ownerAddress),
+		NewBaseFiatPeg(PegHash("a"), "transaction-a", 10, ownerAddress),
+		NewBaseFiatPeg(PegHash("b"), "transaction-b", 20, ownerAddress),
+	}
+}
+
+func checkOwnerAmounts(t *testing.T, name string, fiatPegWallet FiatPegWallet, ownerAddress sdkTypes.AccAddress, expected []testOwnerAmount) {
+	var actual []testOwnerAmount
+	for _, fiatPeg := range fiatPegWallet {
+		if amount := fiatPeg.GetOwnerAmount(ownerAddress); amount > 0 {
+			actual = append(actual, testOwnerAmount{string(fiatPeg.PegHash), amount})
+		}
+	}
+	if len(actual) != len(expected) {
+		t.Fatalf("%s: expected %v, got %v", name, expected, actual)
+	}
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Fatalf("%s: expected %v, got %v", name, expected, actual)
+		}
+	}
+}
+
+func TestAddOwnerAmount(t *testing.T) {
+	ownerAddress := newTestAddress()
+	testCases := []struct {
+		name   string
+		amount int64
+		code   sdkTypes.CodeType
+	}{
+		{"positive", 5, sdkTypes.CodeOK},
+		{"zero", 0, CodeInvalidFiatPeg},
+		{"negative", -5, CodeInvalidFiatPeg},
+	}
+	for _, testCase := range testCases {
+		fiatPeg := NewBaseFiatPeg(PegHash("a"), "transaction-a", 10, ownerAddress)
+		err := fiatPeg.AddOwnerAmount(ownerAddress, testCase.amount)
+		if testCase.code == sdkTypes.CodeOK {
+			if err != nil {
+				t.Fatalf("%s: unexpected error %s", testCase.name, err.Error())
+			}
+			if amount := fiatPeg.GetOwnerAmount(ownerAddress); amount != 10+testCase.amount {
+				t.Fatalf("%s: expected the owner to hold %d, got %d", testCase.name, 10+testCase.amount, amount)
+			}
+			continue
+		}
+		if err == nil || err.Code() != testCase.code {
+			t.Fatalf("%s: expected code %d, got %v", testCase.name, testCase.code, err)
+		}
+		if amount := fiatPeg.GetOwnerAmount(ownerAddress); amount != 10 {
+			t.Fatalf("%s: expected the owner amount to stay 10, got %d", testCase.name, amount)
+		}
+	}
+}
+
+func TestSubtractAmountFromWallet(t *testing.T) {
+	ownerAddress := newTestAddress()
+	testCases := []struct {
+		name       string
+		amount     int64
+		code       sdkTypes.CodeType
+		subtracted []testOwnerAmount
+		remaining  []testOwnerAmount
+	}{
+		{"single peg", 10, sdkTypes.CodeOK, []testOwnerAmount{{"a", 10}}, []testOwnerAmount{{"b", 20}, {"c", 30}}},
+		{"split peg", 15, sdkTypes.CodeOK, []testOwnerAmount{{"a", 10}, {"b", 5}}, []testOwnerAmount{{"b", 15}, {"c", 30}}},
+		{"exact balance", 60, sdkTypes.CodeOK, []testOwnerAmount{{"a", 10}, {"b", 20}, {"c", 30}}, nil},
+		{"insufficient balance", 61, CodeInsufficientFiat, nil, []testOwnerAmount{{"c", 30}, {"a", 10}, {"b", 20}}},
+		{"zero amount", 0, CodeInvalidFiatPeg, nil, []testOwnerAmount{{"c", 30}, {"a", 10}, {"b", 20}}},
+	}
+	for _, testCase := range testCases {
+		fiatPegWallet := newTestWallet(ownerAddress)
+		subtractedFiatPegWallet, remainingFiatPegWallet, err := SubtractAmountFromWallet(fiatPegWallet, ownerAddress, testCase.amount)
+		if testCase.code == sdkTypes.CodeOK && err != nil {
+			t.Fatalf("%s: unexpected error %s", testCase.name, err.Error())
+		}
+		if testCase.code != sdkTypes.CodeOK && (err == nil || err.Code() != testCase.code) {
+			t.Fatalf("%s: expected code %d, got %v", testCase.name, testCase.code, err)
+		}
+
+		checkOwnerAmounts(t, testCase.name, subtractedFiatPegWallet, ownerAddress, testCase.subtracted)
+		checkOwnerAmounts(t, testCase.name, remainingFiatPegWallet, ownerAddress, testCase.remaining)
+		checkOwnerAmounts(t, testCase.name+" input", fiatPegWallet, ownerAddress, []testOwnerAmount{{"c", 30}, {"a", 10}, {"b", 20}})
+		if err == nil && len(remainingFiatPegWallet) != len(fiatPegWallet) {
+			t.Fatalf("%s: expected the remaining wallet to keep all %d pegs, got %d", testCase.name, len(fiatPegWallet), len(remainingFiatPegWallet))
+		}
+	}
+}
+
+func TestTransferAmountInWallet(t *testing.T) {
+	fromAddress, toAddress := newTestAddress(), newTestAddress()
+	testCases := []struct {
+		name   string
+		amount int64
+		code   sdkTypes.CodeType
+		from   []testOwnerAmount
+		to     []testOwnerAmount
+	}{
+		{"split peg", 15, sdkTypes.CodeOK, []testOwnerAmount{{"b", 15}, {"c", 30}}, []testOwnerAmount{{"a", 10}, {"b", 5}}},
+		{"exact balance", 60, sdkTypes.CodeOK, nil, []testOwnerAmount{{"a", 10}, {"b", 20}, {"c", 30}}},
+		{"insufficient balance", 61, CodeInsufficientFiat, []testOwnerAmount{{"c", 30}, {"a", 10}, {"b", 20}}, nil},
+		{"negative amount", -1, CodeInvalidFiatPeg, []testOwnerAmount{{"c", 30}, {"a", 10}, {"b", 20}}, nil},
+	}
+	for _, testCase := range testCases {
+		fiatPegWallet, err := TransferAmountInWallet(newTestWallet(fromAddress), fromAddress, toAddress, testCase.amount)
+		if testCase.code == sdkTypes.CodeOK && err != nil {
+			t.Fatalf("%s: unexpected error %s", testCase.name, err.Error())
+		}
+		if testCase.code != sdkTypes.CodeOK && (err == nil || err.Code() != testCase.code) {
+			t.Fatalf("%s: expected code %d, got %v", testCase.name, testCase.code, err)
+		}
+
+		checkOwnerAmounts(t, testCase.name, fiatPegWallet, fromAddress, testCase.from)
+		checkOwnerAmounts(t, testCase.name, fiatPegWallet, toAddress, testCase.to)
+		if err := fiatPegWallet.ValidateBasic(); err != nil {
+			t.Fatalf("%s: invalid wallet after transfer: %s", testCase.name, err.Error())
+		}
+		if amount := fiatPegWallet.Amount(); amount != 60 {
+			t.Fatalf("%s: expected the wallet to keep 60 outstanding, got %d", testCase.name, amount)
+		}
+	}
+}
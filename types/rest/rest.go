@@ -0,0 +1,66 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+)
+
+func ReadBaseRequest(responseWriter http.ResponseWriter, request *http.Request, cliContext context.CLIContext, body interface{}, baseRequest *rest.BaseReq) (sdkTypes.AccAddress, bool) {
+	if !rest.ReadRESTReq(responseWriter, request, cliContext.Codec, body) {
+		return nil, false
+	}
+
+	*baseRequest = baseRequest.Sanitize()
+	if !baseRequest.ValidateBasic(responseWriter) {
+		return nil, false
+	}
+
+	fromAddress, err := sdkTypes.AccAddressFromBech32(baseRequest.From)
+	if err != nil {
+		rest.WriteErrorResponse(responseWriter, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+	return fromAddress, true
+}
+func WriteGenerateStdTxResponse(responseWriter http.ResponseWriter, cliContext context.CLIContext, baseRequest rest.BaseReq, msg sdkTypes.Msg) {
+	if err := msg.ValidateBasic(); err != nil {
+		rest.WriteErrorResponse(responseWriter, http.StatusBadRequest, err.Error())
+		return
+	}
+	utils.WriteGenerateStdTxResponse(responseWriter, cliContext, baseRequest, []sdkTypes.Msg{msg})
+}
+func QueryWithParams(responseWriter http.ResponseWriter, request *http.Request, cliContext context.CLIContext, path string, params interface{}) {
+	cliContext, ok := rest.ParseQueryHeightOrReturnBadRequest(responseWriter, cliContext, request)
+	if !ok {
+		return
+	}
+
+	var bytes []byte
+	if params != nil {
+		var err error
+		bytes, err = cliContext.Codec.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(responseWriter, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+	res, height, err := cliContext.QueryWithData(path, bytes)
+	if err != nil {
+		rest.WriteErrorResponse(responseWriter, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	cliContext = cliContext.WithHeight(height)
+	rest.PostProcessResponse(responseWriter, cliContext, res)
+}
+func ParseIntOrDefault(value string, defaultValue int) (int, error) {
+	if value == "" {
+		return defaultValue, nil
+	}
+	return strconv.Atoi(value)
+}